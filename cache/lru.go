@@ -0,0 +1,198 @@
+package cache
+
+import (
+	"github.com/slashvar/go-toolbox/utils"
+)
+
+// sentinel is the index of the sentinel node in the ring of nodes
+const sentinel = 0
+
+// node[K, V] is an entry of the cache, nodes are linked using their indices in the storage
+type node[K comparable, V any] struct {
+	key   K
+	value V
+	cost  int
+	prev  int
+	next  int
+}
+
+// LRU[K, V] describes a least recently used cache mapping keys of type K to values of type V
+// Entries are stored in a single slice and linked by index in a ring, most recently used entry first,
+// freed entries are recycled so steady state usage does not allocate
+type LRU[K comparable, V any] struct {
+	nodes    []node[K, V]
+	index    map[K]int
+	free     int
+	cost     int
+	capacity int
+	weight   func(K, V) int
+	onEvict  func(K, V)
+}
+
+// New[K, V] creates an empty LRU[K, V] holding at most capacity entries
+func New[K comparable, V any](capacity int) *LRU[K, V] {
+	return NewWeighted(capacity, func(K, V) int { return 1 })
+}
+
+// NewWeighted[K, V] creates an empty LRU[K, V] whose total cost stays below capacity, the cost of
+// an entry is given by weight
+func NewWeighted[K comparable, V any](capacity int, weight func(K, V) int) *LRU[K, V] {
+	return &LRU[K, V]{
+		nodes:    []node[K, V]{{prev: sentinel, next: sentinel}},
+		index:    map[K]int{},
+		free:     sentinel,
+		cost:     0,
+		capacity: capacity,
+		weight:   weight,
+		onEvict:  nil,
+	}
+}
+
+// OnEvict sets f as the function called on entries evicted to make room for new ones
+func (c *LRU[K, V]) OnEvict(f func(K, V)) {
+	c.onEvict = f
+}
+
+// Size returns the number of entries in the cache
+func (c *LRU[K, V]) Size() int {
+	return len(c.index)
+}
+
+// Cost returns the total cost of the entries in the cache
+func (c *LRU[K, V]) Cost() int {
+	return c.cost
+}
+
+// Capacity returns the maximal total cost of the cache
+func (c *LRU[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// unlink removes node i from the ring
+func (c *LRU[K, V]) unlink(i int) {
+	prev, next := c.nodes[i].prev, c.nodes[i].next
+	c.nodes[prev].next = next
+	c.nodes[next].prev = prev
+}
+
+// pushFront inserts node i at the front of the ring
+func (c *LRU[K, V]) pushFront(i int) {
+	next := c.nodes[sentinel].next
+	c.nodes[i].prev = sentinel
+	c.nodes[i].next = next
+	c.nodes[next].prev = i
+	c.nodes[sentinel].next = i
+}
+
+// alloc returns the index of an unused node, reusing freed nodes first
+func (c *LRU[K, V]) alloc() int {
+	if c.free != sentinel {
+		i := c.free
+		c.free = c.nodes[i].next
+		return i
+	}
+	c.nodes = append(c.nodes, node[K, V]{})
+	return len(c.nodes) - 1
+}
+
+// release unlinks node i, forgets its content and puts it in the free list
+func (c *LRU[K, V]) release(i int) (K, V) {
+	n := c.nodes[i]
+	c.unlink(i)
+	delete(c.index, n.key)
+	c.cost -= n.cost
+	c.nodes[i] = node[K, V]{next: c.free}
+	c.free = i
+	return n.key, n.value
+}
+
+// evict removes least recently used entries until the total cost fits in the capacity
+func (c *LRU[K, V]) evict() {
+	for c.cost > c.capacity && len(c.index) > 0 {
+		k, v := c.release(c.nodes[sentinel].prev)
+		if c.onEvict != nil {
+			c.onEvict(k, v)
+		}
+	}
+}
+
+// Get returns the value associated with k and marks it as the most recently used entry,
+// returns an empty optional value if k is not in the cache
+func (c *LRU[K, V]) Get(k K) utils.Option[V] {
+	i, ok := c.index[k]
+	if !ok {
+		return utils.NilOption[V]()
+	}
+	c.unlink(i)
+	c.pushFront(i)
+	return utils.NewOption(c.nodes[i].value)
+}
+
+// Peek returns the value associated with k without changing its position in the cache
+func (c *LRU[K, V]) Peek(k K) utils.Option[V] {
+	i, ok := c.index[k]
+	if !ok {
+		return utils.NilOption[V]()
+	}
+	return utils.NewOption(c.nodes[i].value)
+}
+
+// Put associates v with k and marks it as the most recently used entry, least recently used
+// entries are evicted if needed
+// An entry whose cost is greater than the capacity is evicted immediately
+func (c *LRU[K, V]) Put(k K, v V) {
+	cost := c.weight(k, v)
+	i, ok := c.index[k]
+	if ok {
+		c.unlink(i)
+		c.cost -= c.nodes[i].cost
+	} else {
+		i = c.alloc()
+		c.index[k] = i
+	}
+	c.nodes[i].key = k
+	c.nodes[i].value = v
+	c.nodes[i].cost = cost
+	c.cost += cost
+	if cost > c.capacity {
+		c.pushFront(i)
+		c.release(i)
+		if c.onEvict != nil {
+			c.onEvict(k, v)
+		}
+		return
+	}
+	c.pushFront(i)
+	c.evict()
+}
+
+// Remove removes k from the cache and returns its value if it was present
+// The eviction callback is not called
+func (c *LRU[K, V]) Remove(k K) utils.Option[V] {
+	i, ok := c.index[k]
+	if !ok {
+		return utils.NilOption[V]()
+	}
+	_, v := c.release(i)
+	return utils.NewOption(v)
+}
+
+// Clear removes all entries from the cache without calling the eviction callback
+func (c *LRU[K, V]) Clear() {
+	// zero the released nodes so the backing array does not keep evicted keys and values alive
+	clear(c.nodes[1:])
+	c.nodes = c.nodes[:1]
+	c.nodes[sentinel] = node[K, V]{prev: sentinel, next: sentinel}
+	c.index = map[K]int{}
+	c.free = sentinel
+	c.cost = 0
+}
+
+// Keys returns the keys in the cache from the most recently used to the least recently used
+func (c *LRU[K, V]) Keys() []K {
+	r := make([]K, 0, len(c.index))
+	for i := c.nodes[sentinel].next; i != sentinel; i = c.nodes[i].next {
+		r = append(r, c.nodes[i].key)
+	}
+	return r
+}
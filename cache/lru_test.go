@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPut(t *testing.T) {
+	c := New[int, string](3)
+	require.False(t, c.Get(1).HasValue())
+	for i := 0; i < 3; i++ {
+		c.Put(i, strconv.Itoa(i))
+	}
+	require.Equal(t, 3, c.Size())
+	for i := 0; i < 3; i++ {
+		r := c.Get(i)
+		require.True(t, r.HasValue())
+		require.Equal(t, strconv.Itoa(i), r.Value())
+	}
+	c.Put(1, "one")
+	require.Equal(t, 3, c.Size())
+	require.Equal(t, "one", c.Get(1).Value())
+	require.Equal(t, []int{1, 2, 0}, c.Keys())
+}
+
+func TestEviction(t *testing.T) {
+	type testCases struct {
+		name    string
+		actions func(c *LRU[int, int])
+		evicted []int
+		keys    []int
+	}
+	cases := []testCases{
+		{
+			name: "evict oldest",
+			actions: func(c *LRU[int, int]) {
+				for i := 0; i < 5; i++ {
+					c.Put(i, i)
+				}
+			},
+			evicted: []int{0, 1},
+			keys:    []int{4, 3, 2},
+		},
+		{
+			name: "Get refreshes entries",
+			actions: func(c *LRU[int, int]) {
+				c.Put(0, 0)
+				c.Put(1, 1)
+				c.Put(2, 2)
+				c.Get(0)
+				c.Put(3, 3)
+			},
+			evicted: []int{1},
+			keys:    []int{3, 0, 2},
+		},
+		{
+			name: "Peek does not refresh entries",
+			actions: func(c *LRU[int, int]) {
+				c.Put(0, 0)
+				c.Put(1, 1)
+				c.Put(2, 2)
+				c.Peek(0)
+				c.Put(3, 3)
+			},
+			evicted: []int{0},
+			keys:    []int{3, 2, 1},
+		},
+		{
+			name: "Remove does not evict",
+			actions: func(c *LRU[int, int]) {
+				c.Put(0, 0)
+				c.Put(1, 1)
+				c.Remove(0)
+				c.Put(2, 2)
+				c.Put(3, 3)
+			},
+			evicted: nil,
+			keys:    []int{3, 2, 1},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New[int, int](3)
+			var evicted []int
+			c.OnEvict(func(k, v int) {
+				require.Equal(t, k, v)
+				evicted = append(evicted, k)
+			})
+			tt.actions(c)
+			require.Equal(t, tt.evicted, evicted)
+			require.Equal(t, tt.keys, c.Keys())
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	c := New[int, int](4)
+	for i := 0; i < 4; i++ {
+		c.Put(i, i*10)
+	}
+	r := c.Remove(2)
+	require.True(t, r.HasValue())
+	require.Equal(t, 20, r.Value())
+	require.False(t, c.Remove(2).HasValue())
+	require.False(t, c.Get(2).HasValue())
+	require.Equal(t, 3, c.Size())
+	// removed nodes are recycled
+	storage := len(c.nodes)
+	c.Put(5, 50)
+	require.Equal(t, storage, len(c.nodes))
+	require.Equal(t, []int{5, 3, 1, 0}, c.Keys())
+	c.Clear()
+	require.Equal(t, 0, c.Size())
+	require.Empty(t, c.Keys())
+	// released nodes do not retain keys and values
+	for _, n := range c.nodes[1:cap(c.nodes)] {
+		require.Zero(t, n)
+	}
+	c.Put(1, 1)
+	require.Equal(t, []int{1}, c.Keys())
+}
+
+func TestWeighted(t *testing.T) {
+	c := NewWeighted(10, func(_ int, v string) int { return len(v) })
+	var evicted []int
+	c.OnEvict(func(k int, _ string) { evicted = append(evicted, k) })
+	c.Put(0, "aaaa")
+	c.Put(1, "bbbb")
+	require.Equal(t, 8, c.Cost())
+	c.Put(2, "cc")
+	require.Equal(t, 10, c.Cost())
+	require.Empty(t, evicted)
+	c.Put(3, "d")
+	require.Equal(t, []int{0}, evicted)
+	require.Equal(t, 7, c.Cost())
+	c.Put(1, "b")
+	require.Equal(t, 4, c.Cost())
+	c.Put(4, "too large for the cache")
+	require.Equal(t, []int{0, 4}, evicted)
+	require.False(t, c.Get(4).HasValue())
+	require.Equal(t, 4, c.Cost())
+	require.Equal(t, []int{1, 3, 2}, c.Keys())
+}
+
+func TestSharded(t *testing.T) {
+	hash := func(k int) uint64 { return uint64(k) }
+	c := NewSharded(4, hash, func() *LRU[int, int] { return New[int, int](8) })
+	var (
+		wg         sync.WaitGroup
+		mismatches atomic.Int32
+	)
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				k := (w*1000 + i) % 64
+				c.Put(k, k)
+				c.Get(k).Do(func(v int) {
+					if v != k {
+						mismatches.Add(1)
+					}
+				})
+				if i%3 == 0 {
+					c.Remove(k)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	require.Zero(t, mismatches.Load())
+	require.LessOrEqual(t, c.Size(), 32)
+	c.Put(1, 1)
+	require.Equal(t, 1, c.Peek(1).Value())
+	c.Clear()
+	require.Equal(t, 0, c.Size())
+	require.Panics(t, func() { NewSharded(0, hash, func() *LRU[int, int] { return New[int, int](1) }) })
+}
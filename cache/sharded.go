@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/slashvar/go-toolbox/utils"
+)
+
+// shard is an LRU protected by a mutex
+type shard[K comparable, V any] struct {
+	sync.Mutex
+	lru *LRU[K, V]
+}
+
+// Sharded[K, V] is an LRU cache safe for concurrent use
+// Keys are distributed over several independent LRU caches (shards) using a hash function, so the
+// eviction order is only least recently used within a shard
+type Sharded[K comparable, V any] struct {
+	shards []shard[K, V]
+	hash   func(K) uint64
+}
+
+// NewSharded[K, V] creates a cache with n shards, each shard is built by calling build and keys are
+// dispatched using hash
+// panic if n is not strictly positive
+func NewSharded[K comparable, V any](n int, hash func(K) uint64, build func() *LRU[K, V]) *Sharded[K, V] {
+	if n <= 0 {
+		panic("creating a sharded cache without shards")
+	}
+	shards := make([]shard[K, V], n)
+	for i := range shards {
+		shards[i].lru = build()
+	}
+	return &Sharded[K, V]{
+		shards: shards,
+		hash:   hash,
+	}
+}
+
+// shardOf returns the shard containing k
+func (c *Sharded[K, V]) shardOf(k K) *shard[K, V] {
+	return &c.shards[c.hash(k)%uint64(len(c.shards))]
+}
+
+// Get returns the value associated with k, see LRU.Get
+func (c *Sharded[K, V]) Get(k K) utils.Option[V] {
+	s := c.shardOf(k)
+	s.Lock()
+	defer s.Unlock()
+	return s.lru.Get(k)
+}
+
+// Peek returns the value associated with k, see LRU.Peek
+func (c *Sharded[K, V]) Peek(k K) utils.Option[V] {
+	s := c.shardOf(k)
+	s.Lock()
+	defer s.Unlock()
+	return s.lru.Peek(k)
+}
+
+// Put associates v with k, see LRU.Put
+// The eviction callback is called while holding the lock of the shard
+func (c *Sharded[K, V]) Put(k K, v V) {
+	s := c.shardOf(k)
+	s.Lock()
+	defer s.Unlock()
+	s.lru.Put(k, v)
+}
+
+// Remove removes k from the cache, see LRU.Remove
+func (c *Sharded[K, V]) Remove(k K) utils.Option[V] {
+	s := c.shardOf(k)
+	s.Lock()
+	defer s.Unlock()
+	return s.lru.Remove(k)
+}
+
+// Size returns the number of entries in the cache
+func (c *Sharded[K, V]) Size() int {
+	r := 0
+	for i := range c.shards {
+		c.shards[i].Lock()
+		r += c.shards[i].lru.Size()
+		c.shards[i].Unlock()
+	}
+	return r
+}
+
+// Clear removes all entries from the cache
+func (c *Sharded[K, V]) Clear() {
+	for i := range c.shards {
+		c.shards[i].Lock()
+		c.shards[i].lru.Clear()
+		c.shards[i].Unlock()
+	}
+}
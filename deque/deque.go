@@ -1,6 +1,14 @@
 package deque
 
+import (
+	"iter"
+
+	"github.com/slashvar/go-toolbox/utils"
+	"golang.org/x/exp/constraints"
+)
+
 // Deque[T] describes a double-ended queue of elements of type T
+// The zero value is an empty deque ready to use
 type Deque[T any] struct {
 	buffer   []T
 	first    int
@@ -154,3 +162,114 @@ func (q *Deque[T]) Get(n int) (T, error) {
 	}
 	return q.buffer[(q.first+n)%q.capacity], nil
 }
+
+// at returns the nth element without bound checking
+func (q *Deque[T]) at(n int) T {
+	return q.buffer[(q.first+n)%q.capacity]
+}
+
+// Clone returns a copy of q, the copy does not share storage with q
+func (q *Deque[T]) Clone() *Deque[T] {
+	r := New[T]()
+	if q.capacity == 0 {
+		return r
+	}
+	r.buffer = make([]T, q.capacity)
+	for i := 0; i < q.length; i++ {
+		r.buffer[i] = q.at(i)
+	}
+	r.capacity = q.capacity
+	r.length = q.length
+	return r
+}
+
+// IndexFunc returns the position of the first element e of q such that f(e) is true
+// returns an empty optional value if there is no such element
+func (q *Deque[T]) IndexFunc(f func(T) bool) utils.Option[int] {
+	for i := 0; i < q.length; i++ {
+		if f(q.at(i)) {
+			return utils.NewOption(i)
+		}
+	}
+	return utils.NilOption[int]()
+}
+
+// ContainsFunc returns true if f returns true for at least one element of q
+func (q *Deque[T]) ContainsFunc(f func(T) bool) bool {
+	return q.IndexFunc(f).HasValue()
+}
+
+// EqualFunc returns true if a and b have the same size and eq returns true on all pairs of elements at the same position
+func EqualFunc[T1, T2 any](a *Deque[T1], b *Deque[T2], eq func(T1, T2) bool) bool {
+	if a.length != b.length {
+		return false
+	}
+	for i := 0; i < a.length; i++ {
+		if !eq(a.at(i), b.at(i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if a and b contains the same elements in the same order
+func Equal[T comparable](a, b *Deque[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// Compare compares a and b lexicographically
+// returns -1 if a is smaller than b, 0 if they are equal and +1 if a is greater than b
+func Compare[T constraints.Ordered](a, b *Deque[T]) int {
+	for i := 0; i < a.length && i < b.length; i++ {
+		x, y := a.at(i), b.at(i)
+		if x < y {
+			return -1
+		}
+		if y < x {
+			return 1
+		}
+	}
+	switch {
+	case a.length < b.length:
+		return -1
+	case a.length > b.length:
+		return 1
+	}
+	return 0
+}
+
+// Values returns an iterator over the elements of q from front to back
+// q must not be modified during the iteration
+func (q *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.length; i++ {
+			if !yield(q.at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the positions and elements of q from front to back
+// q must not be modified during the iteration
+func (q *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < q.length; i++ {
+			if !yield(i, q.at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the positions and elements of q from back to front
+// q must not be modified during the iteration
+func (q *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := q.length - 1; i >= 0; i-- {
+			if !yield(i, q.at(i)) {
+				return
+			}
+		}
+	}
+}
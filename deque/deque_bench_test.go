@@ -0,0 +1,372 @@
+package deque
+
+import (
+	"container/list"
+	"math/rand"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// benchSizes are the numbers of elements used by size dependent benchmarks
+var benchSizes = []int{16, 1024, 65536}
+
+// sink prevents the compiler from optimizing away benchmarked reads
+var sink int
+
+func BenchmarkPushPopBack(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run("impl=Deque/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			q := New[int]()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					q.PushBack(j)
+				}
+				for j := 0; j < n; j++ {
+					v, _ := q.Back()
+					sink += v
+					_ = q.PopBack()
+				}
+			}
+		})
+		b.Run("impl=List/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			l := list.New()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					l.PushBack(j)
+				}
+				for j := 0; j < n; j++ {
+					sink += l.Remove(l.Back()).(int)
+				}
+			}
+		})
+		b.Run("impl=Slice/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			var s []int
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					s = append(s, j)
+				}
+				for j := 0; j < n; j++ {
+					sink += s[len(s)-1]
+					s = s[:len(s)-1]
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkPushPopFront(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run("impl=Deque/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			q := New[int]()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					q.PushFront(j)
+				}
+				for j := 0; j < n; j++ {
+					v, _ := q.Front()
+					sink += v
+					_ = q.PopFront()
+				}
+			}
+		})
+		b.Run("impl=List/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			l := list.New()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					l.PushFront(j)
+				}
+				for j := 0; j < n; j++ {
+					sink += l.Remove(l.Front()).(int)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkQueue(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run("impl=Deque/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			q := New[int]()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					q.PushBack(j)
+				}
+				for j := 0; j < n; j++ {
+					v, _ := q.Front()
+					sink += v
+					_ = q.PopFront()
+				}
+			}
+		})
+		b.Run("impl=List/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			l := list.New()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					l.PushBack(j)
+				}
+				for j := 0; j < n; j++ {
+					sink += l.Remove(l.Front()).(int)
+				}
+			}
+		})
+		b.Run("impl=Slice/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			var s []int
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					s = append(s, j)
+				}
+				for j := 0; j < n; j++ {
+					sink += s[0]
+					s = s[1:]
+				}
+			}
+		})
+		b.Run("impl=Channel/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			c := make(chan int, n)
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					c <- j
+				}
+				for j := 0; j < n; j++ {
+					sink += <-c
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	for _, n := range benchSizes {
+		idx := make([]int, 1024)
+		for i := range idx {
+			idx[i] = rand.Intn(n)
+		}
+		b.Run("impl=Deque/n="+strconv.Itoa(n), func(b *testing.B) {
+			q := New[int]()
+			for j := 0; j < n; j++ {
+				q.PushFront(j)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				v, _ := q.Get(idx[i%len(idx)])
+				sink += v
+			}
+		})
+		b.Run("impl=Slice/n="+strconv.Itoa(n), func(b *testing.B) {
+			s := make([]int, n)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				sink += s[idx[i%len(idx)]]
+			}
+		})
+		b.Run("impl=List/n="+strconv.Itoa(n), func(b *testing.B) {
+			l := list.New()
+			for j := 0; j < n; j++ {
+				l.PushBack(j)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				e := l.Front()
+				for k := 0; k < idx[i%len(idx)]; k++ {
+					e = e.Next()
+				}
+				sink += e.Value.(int)
+			}
+		})
+	}
+}
+
+func BenchmarkGrowth(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run("impl=Deque/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				q := New[int]()
+				for j := 0; j < n; j++ {
+					q.PushBack(j)
+				}
+			}
+		})
+		b.Run("impl=DequeShrink/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			q := New[int]()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					q.PushBack(j)
+				}
+				q.Clear()
+				q.ShrinkToFit()
+			}
+		})
+		b.Run("impl=List/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				l := list.New()
+				for j := 0; j < n; j++ {
+					l.PushBack(j)
+				}
+			}
+		})
+		b.Run("impl=Slice/n="+strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var s []int
+				for j := 0; j < n; j++ {
+					s = append(s, j)
+				}
+				sink += len(s)
+			}
+		})
+	}
+}
+
+// bfsTree is the size of the implicit binary tree traversed by BenchmarkBFS
+const bfsTree = 1 << 16
+
+func BenchmarkBFS(b *testing.B) {
+	b.Run("impl=Deque", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			q := New[int]()
+			q.PushBack(0)
+			for !q.IsEmpty() {
+				cur, _ := q.Front()
+				_ = q.PopFront()
+				sink += cur
+				left, right := children(cur)
+				if left < bfsTree {
+					q.PushBack(left)
+				}
+				if right < bfsTree {
+					q.PushBack(right)
+				}
+			}
+		}
+	})
+	b.Run("impl=List", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			l := list.New()
+			l.PushBack(0)
+			for l.Len() > 0 {
+				cur := l.Remove(l.Front()).(int)
+				sink += cur
+				left, right := children(cur)
+				if left < bfsTree {
+					l.PushBack(left)
+				}
+				if right < bfsTree {
+					l.PushBack(right)
+				}
+			}
+		}
+	})
+	b.Run("impl=Slice", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			s := []int{0}
+			for len(s) > 0 {
+				cur := s[0]
+				s = s[1:]
+				sink += cur
+				left, right := children(cur)
+				if left < bfsTree {
+					s = append(s, left)
+				}
+				if right < bfsTree {
+					s = append(s, right)
+				}
+			}
+		}
+	})
+	b.Run("impl=Channel", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			c := make(chan int, bfsTree)
+			c <- 0
+			for len(c) > 0 {
+				cur := <-c
+				sink += cur
+				left, right := children(cur)
+				if left < bfsTree {
+					c <- left
+				}
+				if right < bfsTree {
+					c <- right
+				}
+			}
+		}
+	})
+}
+
+// payload is a pointer-holding element used to measure the impact on the garbage collector
+type payload struct {
+	key  *int
+	next *payload
+}
+
+func BenchmarkGCPressure(b *testing.B) {
+	const n = 1 << 14
+	var before runtime.MemStats
+	start := func(b *testing.B) {
+		b.ReportAllocs()
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		b.ResetTimer()
+	}
+	report := func(b *testing.B) {
+		var after runtime.MemStats
+		runtime.ReadMemStats(&after)
+		b.ReportMetric(float64(after.NumGC-before.NumGC)/float64(b.N), "gc/op")
+		b.ReportMetric(float64(after.PauseTotalNs-before.PauseTotalNs)/float64(b.N), "gc-pause-ns/op")
+	}
+	b.Run("impl=Deque", func(b *testing.B) {
+		q := New[payload]()
+		start(b)
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				q.PushBack(payload{})
+			}
+			for j := 0; j < n; j++ {
+				_ = q.PopFront()
+			}
+		}
+		report(b)
+	})
+	b.Run("impl=List", func(b *testing.B) {
+		l := list.New()
+		start(b)
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				l.PushBack(payload{})
+			}
+			for j := 0; j < n; j++ {
+				l.Remove(l.Front())
+			}
+		}
+		report(b)
+	})
+	b.Run("impl=Slice", func(b *testing.B) {
+		var s []payload
+		start(b)
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < n; j++ {
+				s = append(s, payload{})
+			}
+			for j := 0; j < n; j++ {
+				s = s[1:]
+			}
+		}
+		report(b)
+	})
+}
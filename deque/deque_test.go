@@ -4,6 +4,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/slashvar/go-toolbox/utils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -429,3 +430,137 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+func TestClone(t *testing.T) {
+	type testCases struct {
+		name     string
+		elem     []int
+		first    int
+		capacity int
+	}
+	cases := []testCases{
+		{name: "Clone empty deque", elem: []int{}, first: 0, capacity: 0},
+		{name: "Clone with some elements", elem: []int{1, 2, 3, 4, 5}, first: 0, capacity: 8},
+		{name: "Clone with some elements not starting at 0", elem: []int{1, 2, 3, 4, 5}, first: 6, capacity: 8},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			q := buildDeque(tt.first, tt.capacity, tt.elem)
+			c := q.Clone()
+			require.True(t, Equal(q, c))
+			require.Equal(t, len(tt.elem), c.Size())
+			c.PushBack(42)
+			require.Equal(t, len(tt.elem), q.Size())
+			if len(tt.elem) > 0 {
+				c.buffer[c.first] = 42
+				f, err := q.Front()
+				require.NoError(t, err)
+				require.Equal(t, tt.elem[0], f)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	type testCases struct {
+		name     string
+		a        *Deque[int]
+		b        *Deque[int]
+		expected bool
+	}
+	cases := []testCases{
+		{name: "empty deques", a: New[int](), b: buildDeque(2, 4, []int{}), expected: true},
+		{name: "same elements same offset", a: buildDeque(0, 4, []int{1, 2, 3}), b: buildDeque(0, 4, []int{1, 2, 3}), expected: true},
+		{name: "same elements different offset", a: buildDeque(0, 4, []int{1, 2, 3}), b: buildDeque(3, 8, []int{1, 2, 3}), expected: true},
+		{name: "different sizes", a: buildDeque(0, 4, []int{1, 2, 3}), b: buildDeque(3, 4, []int{1, 2}), expected: false},
+		{name: "different elements", a: buildDeque(0, 4, []int{1, 2, 3}), b: buildDeque(3, 4, []int{1, 2, 4}), expected: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, Equal(tt.a, tt.b))
+			require.Equal(t, tt.expected, Equal(tt.b, tt.a))
+			eq := func(x int, y int) bool { return x == y }
+			require.Equal(t, tt.expected, EqualFunc(tt.a, tt.b, eq))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	type testCases struct {
+		name     string
+		a        *Deque[int]
+		b        *Deque[int]
+		expected int
+	}
+	cases := []testCases{
+		{name: "empty deques", a: New[int](), b: buildDeque(2, 4, []int{}), expected: 0},
+		{name: "equal deques different offset", a: buildDeque(0, 4, []int{1, 2, 3}), b: buildDeque(3, 4, []int{1, 2, 3}), expected: 0},
+		{name: "smaller element", a: buildDeque(0, 4, []int{1, 2, 3}), b: buildDeque(3, 4, []int{1, 3, 0}), expected: -1},
+		{name: "greater element", a: buildDeque(2, 4, []int{1, 4}), b: buildDeque(1, 4, []int{1, 3, 0}), expected: 1},
+		{name: "prefix", a: buildDeque(0, 4, []int{1, 2}), b: buildDeque(3, 4, []int{1, 2, 3}), expected: -1},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, Compare(tt.a, tt.b))
+			require.Equal(t, -tt.expected, Compare(tt.b, tt.a))
+		})
+	}
+}
+
+func TestIndexFunc(t *testing.T) {
+	q := buildDeque(6, 8, []int{1, 2, 3, 4, 5})
+	for i := 0; i < 5; i++ {
+		v := i + 1
+		r := q.IndexFunc(func(e int) bool { return e == v })
+		require.True(t, r.HasValue())
+		require.Equal(t, i, r.Value())
+		require.True(t, q.ContainsFunc(func(e int) bool { return e == v }))
+	}
+	require.False(t, q.IndexFunc(func(e int) bool { return e > 5 }).HasValue())
+	require.False(t, q.ContainsFunc(func(e int) bool { return e > 5 }))
+	require.False(t, New[int]().ContainsFunc(func(int) bool { return true }))
+}
+
+func TestResultIntegration(t *testing.T) {
+	q := New[int]()
+	r := utils.FromPair(q.Front())
+	require.True(t, r.IsErr())
+	require.True(t, r.Is(NotEnoughElementsError{}))
+	q.PushBack(42)
+	require.Equal(t, 42, utils.FromPair(q.Front()).Unwrap())
+	require.Equal(t, 42, utils.FromPair(q.Get(0)).Unwrap())
+	require.False(t, utils.FromPair(q.Get(1)).ToOption().HasValue())
+}
+
+func TestOptionIntegration(t *testing.T) {
+	q := New[int]()
+	double := func(n int) int { return 2 * n }
+	require.False(t, utils.OptionalMap[int](utils.FromError(q.Front()), double).HasValue())
+	q.PushBack(21)
+	require.Equal(t, 42, utils.OptionalMap[int](utils.FromError(q.Back()), double).Value())
+	require.False(t, utils.FromError(q.Get(1)).HasValue())
+}
+
+func TestIterators(t *testing.T) {
+	q := buildDeque(6, 8, []int{1, 2, 3, 4, 5})
+	var values []int
+	for v := range q.Values() {
+		values = append(values, v)
+	}
+	require.Equal(t, []int{1, 2, 3, 4, 5}, values)
+	for i, v := range q.All() {
+		require.Equal(t, i+1, v)
+	}
+	values = nil
+	for i, v := range q.Backward() {
+		require.Equal(t, i+1, v)
+		values = append(values, v)
+		if v == 3 {
+			break
+		}
+	}
+	require.Equal(t, []int{5, 4, 3}, values)
+	for range New[int]().Values() {
+		require.Fail(t, "empty deque should not produce values")
+	}
+}
@@ -0,0 +1,124 @@
+package deque
+
+// Queue[T] describes a first-in first-out container of elements of type T
+type Queue[T any] interface {
+	IsEmpty() bool
+	Size() int
+	PushBack(T)
+	Front() (T, error)
+	PopFront() error
+}
+
+// Stack[T] describes a last-in first-out container of elements of type T
+type Stack[T any] interface {
+	IsEmpty() bool
+	Size() int
+	PushBack(T)
+	Back() (T, error)
+	PopBack() error
+}
+
+// DoubleEnded[T] describes a container of elements of type T with insertion and removal at both ends
+type DoubleEnded[T any] interface {
+	Queue[T]
+	Stack[T]
+	PushFront(T)
+}
+
+var (
+	_ DoubleEnded[int] = (*Deque[int])(nil)
+	_ Stack[int]       = (*AsStack[int])(nil)
+	_ Queue[int]       = (*AsQueue[int])(nil)
+)
+
+// AsStack[T] restricts a DoubleEnded[T] to the Stack[T] operations
+// The zero value is an empty stack backed by a new Deque[T]
+type AsStack[T any] struct {
+	d DoubleEnded[T]
+}
+
+// NewStack[T] returns a view of d restricted to the Stack[T] operations
+func NewStack[T any](d DoubleEnded[T]) *AsStack[T] {
+	return &AsStack[T]{d: d}
+}
+
+// inner returns the underlying container, creating it if needed
+func (s *AsStack[T]) inner() DoubleEnded[T] {
+	if s.d == nil {
+		s.d = New[T]()
+	}
+	return s.d
+}
+
+// IsEmpty returns true if and only if the stack contains no element
+func (s *AsStack[T]) IsEmpty() bool {
+	return s.inner().IsEmpty()
+}
+
+// Size returns the number of elements in the stack
+func (s *AsStack[T]) Size() int {
+	return s.inner().Size()
+}
+
+// PushBack pushes e on top of the stack
+func (s *AsStack[T]) PushBack(e T) {
+	s.inner().PushBack(e)
+}
+
+// Back returns the element on top of the stack
+// returns NotEnoughElementsError if the stack is empty
+func (s *AsStack[T]) Back() (T, error) {
+	return s.inner().Back()
+}
+
+// PopBack removes the element on top of the stack
+// returns NotEnoughElementsError if the stack is empty
+func (s *AsStack[T]) PopBack() error {
+	return s.inner().PopBack()
+}
+
+// AsQueue[T] restricts a DoubleEnded[T] to the Queue[T] operations
+// The zero value is an empty queue backed by a new Deque[T]
+type AsQueue[T any] struct {
+	d DoubleEnded[T]
+}
+
+// NewQueue[T] returns a view of d restricted to the Queue[T] operations
+func NewQueue[T any](d DoubleEnded[T]) *AsQueue[T] {
+	return &AsQueue[T]{d: d}
+}
+
+// inner returns the underlying container, creating it if needed
+func (q *AsQueue[T]) inner() DoubleEnded[T] {
+	if q.d == nil {
+		q.d = New[T]()
+	}
+	return q.d
+}
+
+// IsEmpty returns true if and only if the queue contains no element
+func (q *AsQueue[T]) IsEmpty() bool {
+	return q.inner().IsEmpty()
+}
+
+// Size returns the number of elements in the queue
+func (q *AsQueue[T]) Size() int {
+	return q.inner().Size()
+}
+
+// PushBack inserts e at the back of the queue
+func (q *AsQueue[T]) PushBack(e T) {
+	q.inner().PushBack(e)
+}
+
+// Front returns the element at the front of the queue
+// returns NotEnoughElementsError if the queue is empty
+func (q *AsQueue[T]) Front() (T, error) {
+	return q.inner().Front()
+}
+
+// PopFront removes the element at the front of the queue
+// returns NotEnoughElementsError if the queue is empty
+func (q *AsQueue[T]) PopFront() error {
+	return q.inner().PopFront()
+}
@@ -0,0 +1,127 @@
+package deque
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestZeroValue(t *testing.T) {
+	var q Deque[int]
+	require.True(t, q.IsEmpty())
+	require.Equal(t, 0, q.Size())
+	_, err := q.Front()
+	require.True(t, errors.Is(err, NotEnoughElementsError{}))
+	_, err = q.Back()
+	require.True(t, errors.Is(err, NotEnoughElementsError{}))
+	_, err = q.Get(0)
+	require.True(t, errors.Is(err, NotEnoughElementsError{}))
+	require.True(t, errors.Is(q.PopFront(), NotEnoughElementsError{}))
+	require.True(t, errors.Is(q.PopBack(), NotEnoughElementsError{}))
+	q.ShrinkToFit()
+	q.Clear()
+	require.True(t, Equal(&q, New[int]()))
+
+	var p Deque[int]
+	p.PushFront(1)
+	p.PushBack(2)
+	f, err := p.Front()
+	require.NoError(t, err)
+	require.Equal(t, 1, f)
+	b, err := p.Back()
+	require.NoError(t, err)
+	require.Equal(t, 2, b)
+}
+
+// drainQueue pops all elements of q in order
+func drainQueue(t *testing.T, q Queue[int]) []int {
+	var r []int
+	for !q.IsEmpty() {
+		e, err := q.Front()
+		require.NoError(t, err)
+		require.NoError(t, q.PopFront())
+		r = append(r, e)
+	}
+	return r
+}
+
+// drainStack pops all elements of s in order
+func drainStack(t *testing.T, s Stack[int]) []int {
+	var r []int
+	for !s.IsEmpty() {
+		e, err := s.Back()
+		require.NoError(t, err)
+		require.NoError(t, s.PopBack())
+		r = append(r, e)
+	}
+	return r
+}
+
+func TestAsQueue(t *testing.T) {
+	type testCases struct {
+		name  string
+		queue Queue[int]
+	}
+	cases := []testCases{
+		{name: "Deque as Queue", queue: New[int]()},
+		{name: "zero AsQueue", queue: &AsQueue[int]{}},
+		{name: "AsQueue over Deque", queue: NewQueue[int](buildDeque(3, 4, []int{}))},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				tt.queue.PushBack(i)
+			}
+			require.Equal(t, 10, tt.queue.Size())
+			require.Equal(t, buildRange(0, 10), drainQueue(t, tt.queue))
+			_, err := tt.queue.Front()
+			require.True(t, errors.Is(err, NotEnoughElementsError{}))
+		})
+	}
+}
+
+func TestAsStack(t *testing.T) {
+	type testCases struct {
+		name  string
+		stack Stack[int]
+	}
+	cases := []testCases{
+		{name: "Deque as Stack", stack: New[int]()},
+		{name: "zero AsStack", stack: &AsStack[int]{}},
+		{name: "AsStack over Deque", stack: NewStack[int](buildDeque(3, 4, []int{}))},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := 9; i >= 0; i-- {
+				tt.stack.PushBack(i)
+			}
+			require.Equal(t, 10, tt.stack.Size())
+			require.Equal(t, buildRange(0, 10), drainStack(t, tt.stack))
+			_, err := tt.stack.Back()
+			require.True(t, errors.Is(err, NotEnoughElementsError{}))
+		})
+	}
+}
+
+func TestAdaptersRestrictSurface(t *testing.T) {
+	var s interface{} = NewStack[int](New[int]())
+	_, ok := s.(DoubleEnded[int])
+	require.False(t, ok)
+	_, ok = s.(Queue[int])
+	require.False(t, ok)
+	var q interface{} = NewQueue[int](New[int]())
+	_, ok = q.(DoubleEnded[int])
+	require.False(t, ok)
+	_, ok = q.(Stack[int])
+	require.False(t, ok)
+}
+
+// buildRange returns the slice of integers in [from, to)
+func buildRange(from, to int) []int {
+	var r []int
+	for i := from; i < to; i++ {
+		r = append(r, i)
+	}
+	return r
+}
@@ -0,0 +1,146 @@
+package history
+
+import (
+	"github.com/slashvar/go-toolbox/deque"
+	"github.com/slashvar/go-toolbox/utils"
+)
+
+// History[T] describes a bounded undo/redo history of actions of type T
+// Actions are recorded in transactions, a transaction is undone or redone as a whole
+type History[T any] struct {
+	undo    *deque.Deque[[]T]
+	redo    *deque.Deque[[]T]
+	pending []T
+	depth   int
+	nesting int
+}
+
+// New[T] creates an empty History[T] keeping at most depth transactions
+// a depth less or equal to 0 means no limit
+func New[T any](depth int) *History[T] {
+	return &History[T]{
+		undo:    deque.New[[]T](),
+		redo:    deque.New[[]T](),
+		pending: nil,
+		depth:   depth,
+		nesting: 0,
+	}
+}
+
+// NoTransactionError is returned when trying to commit while no transaction is open
+type NoTransactionError struct{}
+
+// Error implements error interface
+func (e NoTransactionError) Error() string {
+	return "no open transaction"
+}
+
+// record pushes a transaction on the undo stack, dropping the oldest if needed
+func (h *History[T]) record(actions []T) {
+	if len(actions) == 0 {
+		return
+	}
+	h.undo.PushBack(actions)
+	for h.depth > 0 && h.undo.Size() > h.depth {
+		// cannot fail, the deque is not empty
+		_ = h.undo.PopFront()
+	}
+}
+
+// Do records action a and discards all redoable actions
+// If a transaction is open, a is added to it, otherwise a forms a transaction on its own
+func (h *History[T]) Do(a T) {
+	h.redo.Clear()
+	if h.nesting > 0 {
+		h.pending = append(h.pending, a)
+		return
+	}
+	h.record([]T{a})
+}
+
+// Begin opens a transaction, nested transactions are merged in the outermost one
+func (h *History[T]) Begin() {
+	h.nesting++
+}
+
+// Commit closes the current transaction, the outermost commit records all actions done since the
+// matching Begin as a single transaction
+// returns NoTransactionError if no transaction is open
+func (h *History[T]) Commit() error {
+	if h.nesting == 0 {
+		return NoTransactionError{}
+	}
+	h.nesting--
+	if h.nesting == 0 {
+		h.record(h.pending)
+		h.pending = nil
+	}
+	return nil
+}
+
+// InTransaction returns true if a transaction is open
+func (h *History[T]) InTransaction() bool {
+	return h.nesting > 0
+}
+
+// closeAll commits all open transactions
+func (h *History[T]) closeAll() {
+	for h.nesting > 0 {
+		// cannot fail, a transaction is open
+		_ = h.Commit()
+	}
+}
+
+// reversed returns a reversed copy of s
+func reversed[T any](s []T) []T {
+	r := append([]T{}, s...)
+	utils.Reverse(r)
+	return r
+}
+
+// Undo moves the last transaction to the redo stack and returns its actions, most recent first,
+// so they can be reverted in order
+// Open transactions are committed first
+// returns an empty optional value if there is nothing to undo
+func (h *History[T]) Undo() utils.Option[[]T] {
+	h.closeAll()
+	actions, err := h.undo.Back()
+	if err != nil {
+		return utils.NilOption[[]T]()
+	}
+	_ = h.undo.PopBack()
+	h.redo.PushBack(actions)
+	return utils.NewOption(reversed(actions))
+}
+
+// Redo moves the last undone transaction back to the undo stack and returns its actions in the
+// order they were done
+// returns an empty optional value if there is nothing to redo
+func (h *History[T]) Redo() utils.Option[[]T] {
+	h.closeAll()
+	actions, err := h.redo.Back()
+	if err != nil {
+		return utils.NilOption[[]T]()
+	}
+	_ = h.redo.PopBack()
+	h.undo.PushBack(actions)
+	return utils.NewOption(append([]T{}, actions...))
+}
+
+// CanUndo returns true if there is at least one transaction to undo
+func (h *History[T]) CanUndo() bool {
+	return !h.undo.IsEmpty() || len(h.pending) > 0
+}
+
+// CanRedo returns true if there is at least one transaction to redo
+func (h *History[T]) CanRedo() bool {
+	return !h.redo.IsEmpty()
+}
+
+// Clear forgets all recorded and pending actions
+func (h *History[T]) Clear() {
+	h.undo.Clear()
+	h.redo.Clear()
+	h.pending = nil
+	h.nesting = 0
+}
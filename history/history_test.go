@@ -0,0 +1,97 @@
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUndoRedo(t *testing.T) {
+	h := New[int](0)
+	require.False(t, h.Undo().HasValue())
+	require.False(t, h.Redo().HasValue())
+	for i := 0; i < 5; i++ {
+		h.Do(i)
+	}
+	require.True(t, h.CanUndo())
+	require.False(t, h.CanRedo())
+	for i := 4; i >= 2; i-- {
+		r := h.Undo()
+		require.True(t, r.HasValue())
+		require.Equal(t, []int{i}, r.Value())
+	}
+	require.True(t, h.CanRedo())
+	r := h.Redo()
+	require.True(t, r.HasValue())
+	require.Equal(t, []int{2}, r.Value())
+	h.Do(42)
+	require.False(t, h.CanRedo())
+	require.False(t, h.Redo().HasValue())
+	require.Equal(t, []int{42}, h.Undo().Value())
+	require.Equal(t, []int{2}, h.Undo().Value())
+}
+
+func TestDepth(t *testing.T) {
+	type testCases struct {
+		name     string
+		depth    int
+		actions  int
+		expected []int
+	}
+	cases := []testCases{
+		{name: "unbounded", depth: 0, actions: 5, expected: []int{4, 3, 2, 1, 0}},
+		{name: "below depth", depth: 10, actions: 5, expected: []int{4, 3, 2, 1, 0}},
+		{name: "drop oldest", depth: 3, actions: 5, expected: []int{4, 3, 2}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			h := New[int](tt.depth)
+			for i := 0; i < tt.actions; i++ {
+				h.Do(i)
+			}
+			var undone []int
+			for h.CanUndo() {
+				undone = append(undone, h.Undo().Value()...)
+			}
+			require.Equal(t, tt.expected, undone)
+			require.False(t, h.Undo().HasValue())
+		})
+	}
+}
+
+func TestTransaction(t *testing.T) {
+	h := New[string](2)
+	h.Do("a")
+	h.Begin()
+	h.Do("b")
+	h.Begin()
+	h.Do("c")
+	require.NoError(t, h.Commit())
+	require.True(t, h.InTransaction())
+	h.Do("d")
+	require.NoError(t, h.Commit())
+	require.False(t, h.InTransaction())
+	require.True(t, errors.Is(h.Commit(), NoTransactionError{}))
+
+	h.Begin()
+	require.NoError(t, h.Commit())
+
+	require.Equal(t, []string{"d", "c", "b"}, h.Undo().Value())
+	require.Equal(t, []string{"a"}, h.Undo().Value())
+	require.Equal(t, []string{"a"}, h.Redo().Value())
+	require.Equal(t, []string{"b", "c", "d"}, h.Redo().Value())
+}
+
+func TestUndoCommitsTransaction(t *testing.T) {
+	h := New[int](0)
+	h.Begin()
+	h.Do(1)
+	h.Do(2)
+	require.True(t, h.CanUndo())
+	require.Equal(t, []int{2, 1}, h.Undo().Value())
+	require.False(t, h.InTransaction())
+	h.Clear()
+	require.False(t, h.CanUndo())
+	require.False(t, h.CanRedo())
+}
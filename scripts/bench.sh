@@ -0,0 +1,19 @@
+#!/bin/sh
+# Runs the benchmarks and writes the result in a benchstat friendly format
+#
+# usage: scripts/bench.sh [output file] [benchmark regexp] [count]
+#
+# Compare two runs with:
+#   benchstat old.txt new.txt
+# or compare implementations of a single run with:
+#   benchstat -col /impl bench_output.txt
+
+set -eu
+
+output=${1:-bench_output.txt}
+pattern=${2:-.}
+count=${3:-10}
+
+cd "$(dirname "$0")/.."
+
+go test -run '^$' -bench "$pattern" -benchmem -count "$count" ./... | tee "$output"
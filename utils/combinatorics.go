@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"iter"
+	"math/rand"
+
+	"golang.org/x/exp/constraints"
+)
+
+// NextPermutationFunc rearranges s into the next lexicographically greater permutation w.r.t. cmp
+// returns false and rearranges s into the first (sorted) permutation if s is the last one
+func NextPermutationFunc[T any](s []T, cmp func(T, T) int) bool {
+	i := len(s) - 2
+	for i >= 0 && cmp(s[i], s[i+1]) >= 0 {
+		i--
+	}
+	if i < 0 {
+		Reverse(s)
+		return false
+	}
+	j := len(s) - 1
+	for cmp(s[j], s[i]) <= 0 {
+		j--
+	}
+	s[i], s[j] = s[j], s[i]
+	Reverse(s[i+1:])
+	return true
+}
+
+// NextPermutation rearranges s into the next lexicographically greater permutation
+// returns false and rearranges s into the first (sorted) permutation if s is the last one
+func NextPermutation[T constraints.Ordered](s []T) bool {
+	return NextPermutationFunc(s, compareOrdered[T])
+}
+
+// PrevPermutationFunc rearranges s into the previous lexicographically smaller permutation w.r.t. cmp
+// returns false and rearranges s into the last (reverse sorted) permutation if s is the first one
+func PrevPermutationFunc[T any](s []T, cmp func(T, T) int) bool {
+	return NextPermutationFunc(s, func(a, b T) int { return cmp(b, a) })
+}
+
+// PrevPermutation rearranges s into the previous lexicographically smaller permutation
+// returns false and rearranges s into the last (reverse sorted) permutation if s is the first one
+func PrevPermutation[T constraints.Ordered](s []T) bool {
+	return PrevPermutationFunc(s, compareOrdered[T])
+}
+
+// pick returns the slice of elements of s at the given positions
+func pick[T any](s []T, positions []int) []T {
+	r := make([]T, len(positions))
+	for i, p := range positions {
+		r[i] = s[p]
+	}
+	return r
+}
+
+// Permutations returns a sequence of all the arrangements of the elements of s, elements are distinguished by
+// their position so duplicated elements produce duplicated permutations, use NextPermutation to avoid them
+// Permutations are produced lazily in lexicographic order of positions, each one in a new slice
+func Permutations[T any](s []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		positions := buildRange(len(s))
+		for {
+			if !yield(pick(s, positions)) || !NextPermutation(positions) {
+				return
+			}
+		}
+	}
+}
+
+// Combinations returns a sequence of all the subsets of k elements of s, elements are distinguished by their
+// position and keep their relative order
+// Combinations are produced lazily in lexicographic order of positions, each one in a new slice
+func Combinations[T any](s []T, k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if k < 0 || k > len(s) {
+			return
+		}
+		positions := buildRange(k)
+		for {
+			if !yield(pick(s, positions)) {
+				return
+			}
+			// find the rightmost position that can move forward
+			i := k - 1
+			for i >= 0 && positions[i] == len(s)-k+i {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			positions[i]++
+			for j := i + 1; j < k; j++ {
+				positions[j] = positions[j-1] + 1
+			}
+		}
+	}
+}
+
+// PowerSet returns a sequence of all the subsets of s, by increasing size, see Combinations
+func PowerSet[T any](s []T) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for k := 0; k <= len(s); k++ {
+			for c := range Combinations(s, k) {
+				if !yield(c) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CartesianProduct returns a sequence of all the pairs (x, y) with x in s1 and y in s2, in lexicographic order
+func CartesianProduct[T1, T2 any](s1 []T1, s2 []T2) iter.Seq2[T1, T2] {
+	return func(yield func(T1, T2) bool) {
+		for _, x := range s1 {
+			for _, y := range s2 {
+				if !yield(x, y) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Shuffle randomly permutes s in place using rng (Fisher-Yates), rng can be seeded for reproducible results
+func Shuffle[T any](s []T, rng *rand.Rand) {
+	for i := len(s) - 1; i > 0; i-- {
+		j := rng.Intn(i + 1)
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// buildRange returns the slice of integers in [0, n)
+func buildRange(n int) []int {
+	r := make([]int, n)
+	for i := range r {
+		r[i] = i
+	}
+	return r
+}
@@ -0,0 +1,136 @@
+package utils
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPermutation(t *testing.T) {
+	type testCases struct {
+		name string
+		in   []int
+		all  [][]int
+	}
+	cases := []testCases{
+		{name: "empty", in: []int{}, all: [][]int{{}}},
+		{name: "single", in: []int{1}, all: [][]int{{1}}},
+		{
+			name: "distinct",
+			in:   []int{1, 2, 3},
+			all:  [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}},
+		},
+		{name: "duplicates", in: []int{1, 1, 2}, all: [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := append([]int{}, tt.in...)
+			var next [][]int
+			for ok := true; ok; ok = NextPermutation(s) {
+				next = append(next, append([]int{}, s...))
+			}
+			require.Equal(t, tt.all, next)
+			require.Equal(t, tt.in, s, "wraps around to the first permutation")
+
+			Reverse(s)
+			var prev [][]int
+			for ok := true; ok; ok = PrevPermutation(s) {
+				prev = append(prev, append([]int{}, s...))
+			}
+			Reverse(prev)
+			require.Equal(t, tt.all, prev)
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	s := []string{"a", "b", "c", "d"}
+	seen := map[string]bool{}
+	count := 0
+	for p := range Permutations(s) {
+		require.ElementsMatch(t, s, p)
+		seen[strings.Join(p, "")] = true
+		count++
+	}
+	require.Equal(t, 24, count)
+	require.Len(t, seen, 24)
+	require.Equal(t, []string{"a", "b", "c", "d"}, s, "input is left untouched")
+
+	var first [][]string
+	for p := range Permutations(s) {
+		first = append(first, p)
+		if len(first) == 2 {
+			break
+		}
+	}
+	require.Equal(t, [][]string{{"a", "b", "c", "d"}, {"a", "b", "d", "c"}}, first)
+}
+
+func TestCombinations(t *testing.T) {
+	type testCases struct {
+		name     string
+		k        int
+		expected [][]int
+	}
+	cases := []testCases{
+		{name: "k=0", k: 0, expected: [][]int{{}}},
+		{name: "k=1", k: 1, expected: [][]int{{1}, {2}, {3}, {4}}},
+		{name: "k=2", k: 2, expected: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}}},
+		{name: "k=4", k: 4, expected: [][]int{{1, 2, 3, 4}}},
+		{name: "k>n", k: 5, expected: nil},
+		{name: "k<0", k: -1, expected: nil},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var r [][]int
+			for c := range Combinations([]int{1, 2, 3, 4}, tt.k) {
+				r = append(r, c)
+			}
+			require.Equal(t, tt.expected, r)
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	var r [][]int
+	for p := range PowerSet([]int{1, 2, 3}) {
+		r = append(r, p)
+	}
+	require.Equal(t, [][]int{{}, {1}, {2}, {3}, {1, 2}, {1, 3}, {2, 3}, {1, 2, 3}}, r)
+
+	count := 0
+	for range PowerSet(make([]int, 10)) {
+		count++
+	}
+	require.Equal(t, 1<<10, count)
+}
+
+func TestCartesianProduct(t *testing.T) {
+	var r []string
+	for x, y := range CartesianProduct([]int{1, 2}, []string{"a", "b", "c"}) {
+		r = append(r, string(rune('0'+x))+y)
+	}
+	require.Equal(t, []string{"1a", "1b", "1c", "2a", "2b", "2c"}, r)
+
+	count := 0
+	for range CartesianProduct([]int{}, []int{1}) {
+		count++
+	}
+	require.Zero(t, count)
+}
+
+func TestShuffle(t *testing.T) {
+	s := buildSlice(100, 1)
+	Shuffle(s, rand.New(rand.NewSource(42)))
+	require.NotEqual(t, buildSlice(100, 1), s)
+
+	again := buildSlice(100, 1)
+	Shuffle(again, rand.New(rand.NewSource(42)))
+	require.Equal(t, s, again, "same seed, same shuffle")
+
+	sort.Ints(s)
+	require.Equal(t, buildSlice(100, 1), s)
+}
@@ -0,0 +1,88 @@
+package utils
+
+// Either[L, R] models a value that is either a left value of type L or a right value of type R
+// The zero value is a left value containing the zero value of L
+type Either[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left returns an Either containing the left value v
+func Left[L, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+// Right returns an Either containing the right value v
+func Right[L, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+// IsLeft returns true if e contains a left value
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight returns true if e contains a right value
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// LeftOption returns an optional value containing the left value of e, empty if e is a right value
+func (e Either[L, R]) LeftOption() Option[L] {
+	if e.isRight {
+		return NilOption[L]()
+	}
+	return NewOption(e.left)
+}
+
+// RightOption returns an optional value containing the right value of e, empty if e is a left value
+func (e Either[L, R]) RightOption() Option[R] {
+	if !e.isRight {
+		return NilOption[R]()
+	}
+	return NewOption(e.right)
+}
+
+// Swap returns e with left and right exchanged
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// Fold returns onLeft(v) if e contains the left value v, returns onRight(v) if e contains the right value v
+func Fold[L, R, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft applies f to the left value of e, right values are kept as is
+func MapLeft[L1, L2, R any](e Either[L1, R], f func(L1) L2) Either[L2, R] {
+	if e.isRight {
+		return Right[L2](e.right)
+	}
+	return Left[L2, R](f(e.left))
+}
+
+// MapRight applies f to the right value of e, left values are kept as is
+func MapRight[L, R1, R2 any](e Either[L, R1], f func(R1) R2) Either[L, R2] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+	return Left[L, R2](e.left)
+}
+
+// PartitionEithers splits s into its left values and its right values, keeping their relative order
+func PartitionEithers[L, R any](s []Either[L, R]) ([]L, []R) {
+	var left []L
+	var right []R
+	for _, e := range s {
+		if e.isRight {
+			right = append(right, e.right)
+			continue
+		}
+		left = append(left, e.left)
+	}
+	return left, right
+}
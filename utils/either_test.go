@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEither(t *testing.T) {
+	type testCases struct {
+		name   string
+		either Either[int, string]
+		isLeft bool
+		folded string
+	}
+	cases := []testCases{
+		{name: "zero value", either: Either[int, string]{}, isLeft: true, folded: "0"},
+		{name: "left value", either: Left[int, string](42), isLeft: true, folded: "42"},
+		{name: "right value", either: Right[int]("answer"), isLeft: false, folded: "answer"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			e := tt.either
+			require.Equal(t, tt.isLeft, e.IsLeft())
+			require.Equal(t, !tt.isLeft, e.IsRight())
+			require.Equal(t, tt.isLeft, e.LeftOption().HasValue())
+			require.Equal(t, !tt.isLeft, e.RightOption().HasValue())
+			require.Equal(t, tt.folded, Fold(e, strconv.Itoa, func(s string) string { return s }))
+			s := e.Swap()
+			require.Equal(t, tt.isLeft, s.IsRight())
+			require.Equal(t, e, s.Swap())
+		})
+	}
+}
+
+func TestEitherMap(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+	length := func(s string) int { return len(s) }
+	l := Left[int, string](21)
+	r := Right[int]("answer")
+	require.Equal(t, 42, MapLeft(l, double).LeftOption().Value())
+	require.Equal(t, "answer", MapLeft(r, double).RightOption().Value())
+	require.Equal(t, 21, MapRight(l, length).LeftOption().Value())
+	require.Equal(t, 6, MapRight(r, length).RightOption().Value())
+}
+
+func TestPartitionEithers(t *testing.T) {
+	var in []Either[int, string]
+	for i := 0; i < 10; i++ {
+		if i%3 == 0 {
+			in = append(in, Right[int](strconv.Itoa(i)))
+			continue
+		}
+		in = append(in, Left[int, string](i))
+	}
+	left, right := PartitionEithers(in)
+	require.Equal(t, []int{1, 2, 4, 5, 7, 8}, left)
+	require.Equal(t, []string{"0", "3", "6", "9"}, right)
+	left, right = PartitionEithers([]Either[int, string]{})
+	require.Empty(t, left)
+	require.Empty(t, right)
+}
@@ -0,0 +1,210 @@
+package utils
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+/*
+ * Heap algorithms on slices, inspired by C++ std::make_heap and friends: heaps are max-heaps, the
+ * greatest element (w.r.t. the comparator for *Func variants) is at position 0.
+ */
+
+// greater returns a less function putting the greatest elements w.r.t. cmp first
+func greater[T any](cmp func(T, T) int) func(T, T) bool {
+	return func(a, b T) bool { return cmp(a, b) > 0 }
+}
+
+// MakeHeapFunc reorders s into a heap w.r.t. cmp in linear time
+func MakeHeapFunc[T any](s []T, cmp func(T, T) int) {
+	less := greater(cmp)
+	for i := len(s)/2 - 1; i >= 0; i-- {
+		siftDown(s, i, less)
+	}
+}
+
+// MakeHeap reorders s into a heap in linear time
+func MakeHeap[T constraints.Ordered](s []T) {
+	MakeHeapFunc(s, compareOrdered[T])
+}
+
+// PushHeapFunc inserts x in the heap s and returns the new heap
+func PushHeapFunc[T any](x T, s []T, cmp func(T, T) int) []T {
+	s = append(s, x)
+	siftUp(s, len(s)-1, greater(cmp))
+	return s
+}
+
+// PushHeap inserts x in the heap s and returns the new heap
+func PushHeap[T constraints.Ordered](x T, s []T) []T {
+	return PushHeapFunc(x, s, compareOrdered[T])
+}
+
+// PopHeapFunc moves the greatest element of the heap s at the end of s and returns the remaining heap, s[:len(s)-1]
+// panic if s is empty
+func PopHeapFunc[T any](s []T, cmp func(T, T) int) []T {
+	if len(s) == 0 {
+		panic("pop from an empty heap")
+	}
+	last := len(s) - 1
+	s[0], s[last] = s[last], s[0]
+	siftDown(s[:last], 0, greater(cmp))
+	return s[:last]
+}
+
+// PopHeap moves the greatest element of the heap s at the end of s and returns the remaining heap, s[:len(s)-1]
+// panic if s is empty
+func PopHeap[T constraints.Ordered](s []T) []T {
+	return PopHeapFunc(s, compareOrdered[T])
+}
+
+// SortHeapFunc sorts the heap s in increasing order w.r.t. cmp
+func SortHeapFunc[T any](s []T, cmp func(T, T) int) {
+	for len(s) > 1 {
+		s = PopHeapFunc(s, cmp)
+	}
+}
+
+// SortHeap sorts the heap s in increasing order
+func SortHeap[T constraints.Ordered](s []T) {
+	SortHeapFunc(s, compareOrdered[T])
+}
+
+// IsHeapFunc returns true if s is a heap w.r.t. cmp
+func IsHeapFunc[T any](s []T, cmp func(T, T) int) bool {
+	for i := 1; i < len(s); i++ {
+		if cmp(s[(i-1)/2], s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHeap returns true if s is a heap
+func IsHeap[T constraints.Ordered](s []T) bool {
+	return IsHeapFunc(s, compareOrdered[T])
+}
+
+// Handle[T] identifies an element of a PriorityQueue[T], it remains valid until the element is popped or removed
+type Handle[T any] struct {
+	value T
+	index int
+}
+
+// Value returns the element identified by h
+func (h *Handle[T]) Value() T {
+	return h.value
+}
+
+// PriorityQueue[T] is a queue where the smallest element w.r.t. a less function is served first
+// Elements can be updated or removed through the handle returned by Push
+type PriorityQueue[T any] struct {
+	heap []*Handle[T]
+	less func(T, T) bool
+}
+
+// NewPriorityQueue creates an empty PriorityQueue[T] serving first the smallest elements w.r.t. less
+func NewPriorityQueue[T any](less func(T, T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		heap: nil,
+		less: less,
+	}
+}
+
+// swap exchanges positions i and j of the heap and updates handle indices
+func (q *PriorityQueue[T]) swap(i, j int) {
+	q.heap[i], q.heap[j] = q.heap[j], q.heap[i]
+	q.heap[i].index = i
+	q.heap[j].index = j
+}
+
+// fix restores the heap property around position i
+func (q *PriorityQueue[T]) fix(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !q.less(q.heap[i].value, q.heap[parent].value) {
+			break
+		}
+		q.swap(i, parent)
+		i = parent
+	}
+	for {
+		child := 2*i + 1
+		if child >= len(q.heap) {
+			return
+		}
+		if child+1 < len(q.heap) && q.less(q.heap[child+1].value, q.heap[child].value) {
+			child++
+		}
+		if !q.less(q.heap[child].value, q.heap[i].value) {
+			return
+		}
+		q.swap(i, child)
+		i = child
+	}
+}
+
+// IsEmpty returns true if and only if the queue contains no element
+func (q *PriorityQueue[T]) IsEmpty() bool {
+	return len(q.heap) == 0
+}
+
+// Size returns the number of elements in the queue
+func (q *PriorityQueue[T]) Size() int {
+	return len(q.heap)
+}
+
+// Push inserts v in the queue and returns its handle
+func (q *PriorityQueue[T]) Push(v T) *Handle[T] {
+	h := &Handle[T]{value: v, index: len(q.heap)}
+	q.heap = append(q.heap, h)
+	q.fix(h.index)
+	return h
+}
+
+// Top returns the smallest element of the queue, returns an empty optional if the queue is empty
+func (q *PriorityQueue[T]) Top() *OptionImplem[T] {
+	if q.IsEmpty() {
+		return NilOption[T]()
+	}
+	return NewOption(q.heap[0].value)
+}
+
+// Pop removes and returns the smallest element of the queue, returns an empty optional if the queue is empty
+func (q *PriorityQueue[T]) Pop() *OptionImplem[T] {
+	if q.IsEmpty() {
+		return NilOption[T]()
+	}
+	return q.Remove(q.heap[0])
+}
+
+// Contains returns true if the element identified by h is in the queue
+func (q *PriorityQueue[T]) Contains(h *Handle[T]) bool {
+	return h.index >= 0 && h.index < len(q.heap) && q.heap[h.index] == h
+}
+
+// Update replaces the element identified by h with v and moves it to its new position
+// returns false if h is not in the queue
+func (q *PriorityQueue[T]) Update(h *Handle[T], v T) bool {
+	if !q.Contains(h) {
+		return false
+	}
+	h.value = v
+	q.fix(h.index)
+	return true
+}
+
+// Remove removes the element identified by h and returns it, returns an empty optional if h is not in the queue
+func (q *PriorityQueue[T]) Remove(h *Handle[T]) *OptionImplem[T] {
+	if !q.Contains(h) {
+		return NilOption[T]()
+	}
+	i, last := h.index, len(q.heap)-1
+	q.swap(i, last)
+	q.heap[last] = nil
+	q.heap = q.heap[:last]
+	h.index = -1
+	if i < last {
+		q.fix(i)
+	}
+	return NewOption(h.value)
+}
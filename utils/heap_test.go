@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeapAlgorithms(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for name, in := range selectionInputs(rng) {
+		t.Run(name, func(t *testing.T) {
+			ref := append([]int{}, in...)
+			sort.Ints(ref)
+
+			s := append([]int{}, in...)
+			MakeHeap(s)
+			require.True(t, IsHeap(s))
+			SortHeap(s)
+			require.Equal(t, ref, s)
+
+			var h []int
+			for _, e := range in {
+				h = PushHeap(e, h)
+				require.True(t, IsHeap(h))
+			}
+			for i := len(h) - 1; i >= 0; i-- {
+				h = PopHeap(h)
+				require.True(t, IsHeap(h))
+				require.Equal(t, ref[i], h[:cap(h)][len(h)])
+			}
+		})
+	}
+	require.Panics(t, func() { PopHeap([]int{}) })
+}
+
+func TestHeapFunc(t *testing.T) {
+	reversed := func(a, b int) int { return compareOrdered(b, a) }
+	s := shuffleSlice(100, 1)
+	MakeHeapFunc(s, reversed)
+	require.True(t, IsHeapFunc(s, reversed))
+	require.Equal(t, 0, s[0])
+	s = PushHeapFunc(-1, s, reversed)
+	require.Equal(t, -1, s[0])
+	s = PopHeapFunc(s, reversed)
+	require.Equal(t, -1, s[:len(s)+1][len(s)])
+	SortHeapFunc(s, reversed)
+	expected := buildSlice(100, 1)
+	Reverse(expected)
+	require.Equal(t, expected, s)
+}
+
+func TestIsHeap(t *testing.T) {
+	require.True(t, IsHeap([]int{}))
+	require.True(t, IsHeap([]int{1}))
+	require.True(t, IsHeap([]int{5, 3, 4, 1, 2}))
+	require.False(t, IsHeap([]int{1, 2}))
+	require.False(t, IsHeap([]int{5, 3, 4, 1, 6}))
+}
+
+func TestPriorityQueue(t *testing.T) {
+	q := NewPriorityQueue(func(a, b int) bool { return a < b })
+	require.True(t, q.IsEmpty())
+	require.False(t, q.Top().HasValue())
+	require.False(t, q.Pop().HasValue())
+	in := shuffleSlice(100, 1)
+	handles := map[int]*Handle[int]{}
+	for _, e := range in {
+		handles[e] = q.Push(e)
+	}
+	require.Equal(t, 100, q.Size())
+	require.Equal(t, 0, q.Top().Value())
+
+	// remove multiples of 10, move multiples of 3 (not 10) to negative values
+	for e, h := range handles {
+		require.Equal(t, e, h.Value())
+		switch {
+		case e%10 == 0:
+			require.Equal(t, e, q.Remove(h).Value())
+			require.False(t, q.Contains(h))
+			require.False(t, q.Remove(h).HasValue())
+			require.False(t, q.Update(h, 0))
+		case e%3 == 0:
+			require.True(t, q.Update(h, -e))
+		}
+	}
+	var expected []int
+	for e := 0; e < 100; e++ {
+		switch {
+		case e%10 == 0:
+		case e%3 == 0:
+			expected = append(expected, -e)
+		default:
+			expected = append(expected, e)
+		}
+	}
+	sort.Ints(expected)
+	var popped []int
+	for !q.IsEmpty() {
+		popped = append(popped, q.Pop().Value())
+	}
+	require.Equal(t, expected, popped)
+}
+
+// dijkstra computes shortest distances from 0 in a graph given as adjacency lists of (target, weight)
+func dijkstra(graph [][][2]int) []int {
+	type node struct {
+		id   int
+		dist int
+	}
+	dist := make([]int, len(graph))
+	for i := range dist {
+		dist[i] = math.MaxInt
+	}
+	dist[0] = 0
+	q := NewPriorityQueue(func(a, b node) bool { return a.dist < b.dist })
+	handles := map[int]*Handle[node]{0: q.Push(node{id: 0, dist: 0})}
+	for !q.IsEmpty() {
+		cur := q.Pop().Value()
+		for _, edge := range graph[cur.id] {
+			d := cur.dist + edge[1]
+			if d >= dist[edge[0]] {
+				continue
+			}
+			dist[edge[0]] = d
+			if h, ok := handles[edge[0]]; ok && q.Update(h, node{id: edge[0], dist: d}) {
+				continue
+			}
+			handles[edge[0]] = q.Push(node{id: edge[0], dist: d})
+		}
+	}
+	return dist
+}
+
+// bellmanFord is a reference shortest path implementation
+func bellmanFord(graph [][][2]int) []int {
+	dist := make([]int, len(graph))
+	for i := range dist {
+		dist[i] = math.MaxInt
+	}
+	dist[0] = 0
+	for range graph {
+		for u, edges := range graph {
+			for _, edge := range edges {
+				if dist[u] != math.MaxInt && dist[u]+edge[1] < dist[edge[0]] {
+					dist[edge[0]] = dist[u] + edge[1]
+				}
+			}
+		}
+	}
+	return dist
+}
+
+func TestPriorityQueueDijkstra(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 20; i++ {
+		graph := make([][][2]int, 50)
+		for e := 0; e < 200; e++ {
+			u := rng.Intn(len(graph))
+			graph[u] = append(graph[u], [2]int{rng.Intn(len(graph)), rng.Intn(100)})
+		}
+		require.Equal(t, bellmanFord(graph), dijkstra(graph))
+	}
+}
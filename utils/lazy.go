@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy[T] models a value of type T computed on first use
+// Lazy[T] is safe for concurrent use, the computation runs at most once
+// If the computation panics, every call to Value panics with the same value and the lazy value is never forced
+type Lazy[T any] struct {
+	once   sync.Once
+	f      func() T
+	value  T
+	failed any
+	forced atomic.Bool
+}
+
+// NewLazy returns a lazy value computed by f
+func NewLazy[T any](f func() T) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// Value computes the value if needed and returns it, re-raises the panic of the computation if it failed
+func (l *Lazy[T]) Value() T {
+	l.once.Do(func() {
+		defer func() {
+			if !l.forced.Load() {
+				l.failed = recover()
+				l.f = nil
+				panic(l.failed)
+			}
+		}()
+		l.value = l.f()
+		l.f = nil
+		l.forced.Store(true)
+	})
+	if !l.forced.Load() {
+		panic(l.failed)
+	}
+	return l.value
+}
+
+// Forced returns true if the value has already been computed
+func (l *Lazy[T]) Forced() bool {
+	return l.forced.Load()
+}
+
+// LazyOption[T] is an optional value computed on first use
+// LazyOption[T] implements Option[T], any inspection of its content forces the computation,
+// OptionalMap and OptionalFlatMap on a LazyOption[T] return a LazyOption without forcing it
+type LazyOption[T any] struct {
+	lazy Lazy[Option[T]]
+}
+
+// NewLazyOption returns a lazy optional value computed by f
+func NewLazyOption[T any](f func() Option[T]) *LazyOption[T] {
+	return &LazyOption[T]{lazy: Lazy[Option[T]]{f: f}}
+}
+
+// Force computes the optional value if needed and returns it
+func (o *LazyOption[T]) Force() Option[T] {
+	return o.lazy.Value()
+}
+
+// Forced returns true if the optional value has already been computed
+func (o *LazyOption[T]) Forced() bool {
+	return o.lazy.Forced()
+}
+
+// HasValue forces o and returns true if it has a value
+func (o *LazyOption[T]) HasValue() bool {
+	return o.Force().HasValue()
+}
+
+// Value forces o and returns its content, panic if empty
+func (o *LazyOption[T]) Value() T {
+	return o.Force().Value()
+}
+
+// Do forces o and calls f(o.Value()) if o is not empty
+func (o *LazyOption[T]) Do(f func(T)) Option[T] {
+	return o.Force().Do(f)
+}
+
+// Else forces o and calls f if o is empty
+func (o *LazyOption[T]) Else(f func()) Option[T] {
+	return o.Force().Else(f)
+}
+
+// ValueOr forces o and returns its content or def if o is empty
+func (o *LazyOption[T]) ValueOr(def T) T {
+	return o.Force().ValueOr(def)
+}
+
+// ValueOrElse forces o and returns its content or f() if o is empty
+func (o *LazyOption[T]) ValueOrElse(f func() T) T {
+	return o.Force().ValueOrElse(f)
+}
+
+// ValueOrZero forces o and returns its content or the zero value of T if o is empty
+func (o *LazyOption[T]) ValueOrZero() T {
+	return o.Force().ValueOrZero()
+}
+
+// Get forces o and returns its content and true, or the zero value and false if o is empty
+func (o *LazyOption[T]) Get() (T, bool) {
+	return o.Force().Get()
+}
+
+// OrElse forces o and returns it if it has a value, returns other otherwise
+func (o *LazyOption[T]) OrElse(other Option[T]) Option[T] {
+	return o.Force().OrElse(other)
+}
+
+// And forces o and returns other if o has a value, returns an empty optional otherwise
+func (o *LazyOption[T]) And(other Option[T]) Option[T] {
+	return o.Force().And(other)
+}
+
+// Xor forces o, see OptionImplem.Xor
+func (o *LazyOption[T]) Xor(other Option[T]) Option[T] {
+	return o.Force().Xor(other)
+}
+
+// OkOr forces o, see OptionImplem.OkOr
+func (o *LazyOption[T]) OkOr(err error) Result[T] {
+	return o.Force().OkOr(err)
+}
+
+// Memoize returns a function computing f(k) once per key k and caching the result
+// At most size results are kept, the oldest ones are forgotten first, a size less or equal to 0 means
+// no limit
+// The returned function is safe for concurrent use if f is, f may be called concurrently for a same key
+func Memoize[K comparable, V any](f func(K) V, size int) func(K) V {
+	var mu sync.Mutex
+	cache := map[K]V{}
+	// keys is a ring of the cached keys in insertion order, oldest at position next
+	var keys []K
+	next := 0
+	return func(k K) V {
+		mu.Lock()
+		v, ok := cache[k]
+		mu.Unlock()
+		if ok {
+			return v
+		}
+		v = f(k)
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := cache[k]; ok {
+			return v
+		}
+		switch {
+		case size <= 0:
+		case len(keys) < size:
+			keys = append(keys, k)
+		default:
+			delete(cache, keys[next])
+			keys[next] = k
+			next = (next + 1) % size
+		}
+		cache[k] = v
+		return v
+	}
+}
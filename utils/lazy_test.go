@@ -0,0 +1,138 @@
+package utils
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() int {
+		calls.Add(1)
+		return 42
+	})
+	require.False(t, l.Forced())
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.Equal(t, 42, l.Value())
+		}()
+	}
+	wg.Wait()
+	require.True(t, l.Forced())
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestLazyPanic(t *testing.T) {
+	var calls atomic.Int32
+	l := NewLazy(func() int {
+		calls.Add(1)
+		panic("boom")
+	})
+	require.PanicsWithValue(t, "boom", func() { l.Value() })
+	require.PanicsWithValue(t, "boom", func() { l.Value() }, "the panic is raised again")
+	require.False(t, l.Forced())
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestLazyOption(t *testing.T) {
+	type testCases struct {
+		name     string
+		content  Option[int]
+		hasValue bool
+	}
+	cases := []testCases{
+		{name: "lazy nil option", content: NilOption[int](), hasValue: false},
+		{name: "lazy value", content: NewOption(21), hasValue: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			var o Option[int] = NewLazyOption(func() Option[int] {
+				calls++
+				return tt.content
+			})
+			double := func(n int) int { return 2 * n }
+			half := func(n int) Option[int] { return NewOption(n / 2) }
+			m := OptionalMap(o, double)
+			fm := OptionalFlatMap(m, half)
+			require.Equal(t, 0, calls)
+			require.False(t, o.(*LazyOption[int]).Forced())
+			require.False(t, fm.(*LazyOption[int]).Forced())
+
+			require.Equal(t, tt.hasValue, fm.HasValue())
+			require.Equal(t, 1, calls)
+			require.Equal(t, tt.hasValue, m.HasValue())
+			require.Equal(t, tt.hasValue, o.HasValue())
+			require.Equal(t, 1, calls)
+			if tt.hasValue {
+				require.Equal(t, 42, m.Value())
+				require.Equal(t, 21, fm.Value())
+			}
+			require.Equal(t, tt.content.ValueOr(-1), o.ValueOr(-1))
+			require.Equal(t, tt.content.ValueOrZero(), o.ValueOrZero())
+			require.Equal(t, tt.content.ValueOrElse(func() int { return -1 }), o.ValueOrElse(func() int { return -1 }))
+			v, ok := o.Get()
+			require.Equal(t, tt.hasValue, ok)
+			require.Equal(t, tt.content.ValueOrZero(), v)
+			require.Equal(t, tt.hasValue, o.OrElse(NilOption[int]()).HasValue())
+			require.Equal(t, tt.hasValue, o.And(NewOption(1)).HasValue())
+			require.Equal(t, !tt.hasValue, o.Xor(NewOption(1)).HasValue())
+			require.Equal(t, tt.hasValue, o.OkOr(errTest).IsOk())
+			done, elsed := false, false
+			o.Do(func(int) { done = true }).Else(func() { elsed = true })
+			require.Equal(t, tt.hasValue, done)
+			require.Equal(t, !tt.hasValue, elsed)
+			require.Equal(t, 1, calls)
+		})
+	}
+}
+
+func TestMemoize(t *testing.T) {
+	type testCases struct {
+		name     string
+		size     int
+		keys     []int
+		expected int
+	}
+	cases := []testCases{
+		{name: "unbounded", size: 0, keys: []int{1, 2, 3, 1, 2, 3}, expected: 3},
+		{name: "fits in the cache", size: 3, keys: []int{1, 2, 3, 1, 2, 3}, expected: 3},
+		{name: "oldest forgotten", size: 2, keys: []int{1, 2, 3, 1}, expected: 4},
+		{name: "recent kept", size: 2, keys: []int{1, 2, 3, 3, 2}, expected: 3},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			f := Memoize(func(n int) int {
+				calls++
+				return 2 * n
+			}, tt.size)
+			for _, k := range tt.keys {
+				require.Equal(t, 2*k, f(k))
+			}
+			require.Equal(t, tt.expected, calls)
+		})
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	f := Memoize(func(n int) int { return n * n }, 8)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				k := (w + i) % 16
+				require.Equal(t, k*k, f(k))
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,158 @@
+package utils
+
+// Opt[T] models an optional value stored by value
+// Unlike OptionImplem[T], creating an Opt[T] does not allocate and its methods are statically dispatched,
+// the zero value is an empty optional value
+type Opt[T any] struct {
+	value T
+	ok    bool
+}
+
+// NilOpt returns an empty optional value
+func NilOpt[T any]() Opt[T] {
+	return Opt[T]{}
+}
+
+// NewOpt returns an optional value containing v
+func NewOpt[T any](v T) Opt[T] {
+	return Opt[T]{value: v, ok: true}
+}
+
+// FromOption converts an Option[T] into an Opt[T]
+func FromOption[T any](o Option[T]) Opt[T] {
+	if o == nil || !o.HasValue() {
+		return NilOpt[T]()
+	}
+	return NewOpt(o.Value())
+}
+
+// ToOption converts o into an Option[T]
+func (o Opt[T]) ToOption() Option[T] {
+	if !o.ok {
+		return NilOption[T]()
+	}
+	return NewOption(o.value)
+}
+
+// HasValue returns true if the optional has a value
+func (o Opt[T]) HasValue() bool {
+	return o.ok
+}
+
+// Value returns the content of the optional value, panic if empty
+func (o Opt[T]) Value() T {
+	if !o.ok {
+		panic("request value of an empty optional value")
+	}
+	return o.value
+}
+
+// Do calls f(o.Value()) if o is not empty and returns o in all cases
+func (o Opt[T]) Do(f func(T)) Opt[T] {
+	if o.ok {
+		f(o.value)
+	}
+	return o
+}
+
+// Else calls f if o is empty and returns o in all cases
+func (o Opt[T]) Else(f func()) Opt[T] {
+	if !o.ok {
+		f()
+	}
+	return o
+}
+
+// ValueOr returns the content of the optional value or def if o is empty
+func (o Opt[T]) ValueOr(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+
+// ValueOrElse returns the content of the optional value or f() if o is empty, f is called only if o is empty
+func (o Opt[T]) ValueOrElse(f func() T) T {
+	if !o.ok {
+		return f()
+	}
+	return o.value
+}
+
+// ValueOrZero returns the content of the optional value or the zero value of T if o is empty
+func (o Opt[T]) ValueOrZero() T {
+	return o.value
+}
+
+// Get returns the content of the optional value and true, or the zero value and false if o is empty
+func (o Opt[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns o if it has a value, returns other otherwise
+func (o Opt[T]) OrElse(other Opt[T]) Opt[T] {
+	if o.ok {
+		return o
+	}
+	return other
+}
+
+// And returns other if o has a value, returns an empty optional otherwise
+func (o Opt[T]) And(other Opt[T]) Opt[T] {
+	if o.ok {
+		return other
+	}
+	return NilOpt[T]()
+}
+
+// Xor returns the one of o and other that has a value if exactly one of them has a value,
+// returns an empty optional otherwise
+func (o Opt[T]) Xor(other Opt[T]) Opt[T] {
+	switch {
+	case o.ok && !other.ok:
+		return o
+	case !o.ok && other.ok:
+		return other
+	}
+	return NilOpt[T]()
+}
+
+// OkOr returns a successful result containing the value of o, or a failed result containing err if o is empty
+func (o Opt[T]) OkOr(err error) Result[T] {
+	if !o.ok {
+		return Err[T](err)
+	}
+	return Ok(o.value)
+}
+
+// OptMap returns an optional value result from f(o.Value()) if o is not empty, returns an empty optional otherwise
+func OptMap[T1, T2 any](o Opt[T1], f func(T1) T2) Opt[T2] {
+	if o.ok {
+		return NewOpt(f(o.value))
+	}
+	return NilOpt[T2]()
+}
+
+// OptFlatMap returns the result of f(o.Value()) if o has a value, returns an empty optional otherwise
+func OptFlatMap[T1, T2 any](o Opt[T1], f func(T1) Opt[T2]) Opt[T2] {
+	if o.ok {
+		return f(o.value)
+	}
+	return NilOpt[T2]()
+}
+
+// OptFilter returns the slice made of f(e) for all e in s such that f(e).HasValue() is true
+func OptFilter[T1, T2 any](f func(T1) Opt[T2], s []T1) []T2 {
+	return OptFilterAppend(nil, f, s)
+}
+
+// OptFilterAppend appends to dst the values of f(e) for all e in s such that f(e).HasValue() is true and returns
+// the extended slice, no allocation happens when dst has enough capacity (reuse dst[:0] in hot paths)
+func OptFilterAppend[T1, T2 any](dst []T2, f func(T1) Opt[T2], s []T1) []T2 {
+	for _, e := range s {
+		if o := f(e); o.ok {
+			dst = append(dst, o.value)
+		}
+	}
+	return dst
+}
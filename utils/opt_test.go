@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpt(t *testing.T) {
+	type testCases struct {
+		name     string
+		opt      Opt[int]
+		hasValue bool
+		value    int
+	}
+	cases := []testCases{
+		{name: "zero value", opt: Opt[int]{}, hasValue: false},
+		{name: "nil opt", opt: NilOpt[int](), hasValue: false},
+		{name: "some value", opt: NewOpt(42), hasValue: true, value: 42},
+		{name: "from nil option", opt: FromOption[int](NilOption[int]()), hasValue: false},
+		{name: "from nil interface", opt: FromOption[int](nil), hasValue: false},
+		{name: "from option", opt: FromOption[int](NewOption(42)), hasValue: true, value: 42},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.hasValue, tt.opt.HasValue())
+			o := tt.opt.ToOption()
+			require.Equal(t, tt.hasValue, o.HasValue())
+			if tt.hasValue {
+				require.Equal(t, tt.value, tt.opt.Value())
+				require.Equal(t, tt.value, o.Value())
+				return
+			}
+			require.Panics(t, func() { tt.opt.Value() })
+		})
+	}
+}
+
+func TestOptDoElse(t *testing.T) {
+	done, elsed := false, false
+	NewOpt(42).Do(func(int) { done = true }).Else(func() { elsed = true })
+	require.True(t, done)
+	require.False(t, elsed)
+	done = false
+	NilOpt[int]().Do(func(int) { done = true }).Else(func() { elsed = true })
+	require.False(t, done)
+	require.True(t, elsed)
+}
+
+func TestOptMap(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+	require.False(t, OptMap(NilOpt[int](), double).HasValue())
+	require.Equal(t, 84, OptMap(NewOpt(42), double).Value())
+	half := func(n int) Opt[int] {
+		if n%2 == 0 {
+			return NewOpt(n / 2)
+		}
+		return NilOpt[int]()
+	}
+	require.False(t, OptFlatMap(NilOpt[int](), half).HasValue())
+	require.False(t, OptFlatMap(NewOpt(3), half).HasValue())
+	require.Equal(t, 21, OptFlatMap(NewOpt(42), half).Value())
+	require.Equal(t, buildSlice(5, 1), OptFilter(half, buildSlice(10, 1)))
+	require.Empty(t, OptFilter(half, []int{}))
+}
+
+func TestOptAllocations(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+	odd := func(n int) Opt[int] {
+		if n%2 == 1 {
+			return NewOpt(n)
+		}
+		return NilOpt[int]()
+	}
+	in := buildSlice(100, 1)
+	dst := make([]int, 0, len(in))
+	allocs := testing.AllocsPerRun(100, func() {
+		o := OptMap(NewOpt(21), double)
+		o.Do(func(int) {})
+		dst = OptFilterAppend(dst[:0], odd, in)
+	})
+	require.Zero(t, allocs)
+	require.Len(t, dst, 50, "half of the elements pass the filter")
+
+	// without a destination buffer, the result has to grow
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = OptFilter(odd, in)
+	})
+	require.NotZero(t, allocs)
+}
+
+var benchSink int
+
+func BenchmarkOptionalMap(b *testing.B) {
+	double := func(n int) int { return 2 * n }
+	b.Run("impl=Option", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var o Option[int] = NewOption(i)
+			benchSink += OptionalMap(o, double).Value()
+		}
+	})
+	b.Run("impl=Opt", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink += OptMap(NewOpt(i), double).Value()
+		}
+	})
+}
+
+func BenchmarkOptionalFilter(b *testing.B) {
+	in := buildSlice(1024, 1)
+	b.Run("impl=Option", func(b *testing.B) {
+		half := func(n int) Option[int] {
+			if n%2 == 0 {
+				return NewOption(n / 2)
+			}
+			return NilOption[int]()
+		}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink += len(OptionalFilter(half, in))
+		}
+	})
+	b.Run("impl=Opt", func(b *testing.B) {
+		half := func(n int) Opt[int] {
+			if n%2 == 0 {
+				return NewOpt(n / 2)
+			}
+			return NilOpt[int]()
+		}
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink += len(OptFilter(half, in))
+		}
+	})
+	b.Run("impl=OptAppend", func(b *testing.B) {
+		half := func(n int) Opt[int] {
+			if n%2 == 0 {
+				return NewOpt(n / 2)
+			}
+			return NilOpt[int]()
+		}
+		dst := make([]int, 0, len(in))
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			dst = OptFilterAppend(dst[:0], half, in)
+			benchSink += len(dst)
+		}
+	})
+}
+
+func TestOptCombinators(t *testing.T) {
+	some2, some3, none := NewOpt(2), NewOpt(3), NilOpt[int]()
+	require.Equal(t, 2, some2.ValueOr(-1))
+	require.Equal(t, -1, none.ValueOr(-1))
+	require.Equal(t, -1, none.ValueOrElse(func() int { return -1 }))
+	require.Equal(t, 0, none.ValueOrZero())
+	v, ok := some2.Get()
+	require.True(t, ok)
+	require.Equal(t, 2, v)
+	require.Equal(t, some2, some2.OrElse(some3))
+	require.Equal(t, some3, none.OrElse(some3))
+	require.Equal(t, some3, some2.And(some3))
+	require.Equal(t, none, none.And(some3))
+	require.Equal(t, none, some2.Xor(some3))
+	require.Equal(t, some3, none.Xor(some3))
+	require.Equal(t, some2, some2.Xor(none))
+	require.True(t, none.OkOr(errTest).Is(errTest))
+	require.Equal(t, 2, some2.OkOr(errTest).Unwrap())
+}
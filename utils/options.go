@@ -1,10 +1,19 @@
 package utils
 
+// Option[T] describes an optional value of type T
 type Option[T any] interface {
 	HasValue() bool
 	Value() T
 	Do(func(T)) Option[T]
 	Else(func()) Option[T]
+	ValueOr(T) T
+	ValueOrElse(func() T) T
+	ValueOrZero() T
+	Get() (T, bool)
+	OrElse(Option[T]) Option[T]
+	And(Option[T]) Option[T]
+	Xor(Option[T]) Option[T]
+	OkOr(error) Result[T]
 }
 
 // OptionImplem model an optional value
@@ -51,8 +60,75 @@ func (o *OptionImplem[T]) Else(f func()) Option[T] {
 	return o
 }
 
+// ValueOr returns the content of the optional value or def if o is empty
+func (o *OptionImplem[T]) ValueOr(def T) T {
+	if o == nil {
+		return def
+	}
+	return o.content
+}
+
+// ValueOrElse returns the content of the optional value or f() if o is empty, f is called only if o is empty
+func (o *OptionImplem[T]) ValueOrElse(f func() T) T {
+	if o == nil {
+		return f()
+	}
+	return o.content
+}
+
+// ValueOrZero returns the content of the optional value or the zero value of T if o is empty
+func (o *OptionImplem[T]) ValueOrZero() T {
+	var zero T
+	return o.ValueOr(zero)
+}
+
+// Get returns the content of the optional value and true, or the zero value and false if o is empty
+func (o *OptionImplem[T]) Get() (T, bool) {
+	return o.ValueOrZero(), o.HasValue()
+}
+
+// OrElse returns o if it has a value, returns other otherwise
+func (o *OptionImplem[T]) OrElse(other Option[T]) Option[T] {
+	if o.HasValue() {
+		return o
+	}
+	return other
+}
+
+// And returns other if o has a value, returns an empty optional otherwise
+func (o *OptionImplem[T]) And(other Option[T]) Option[T] {
+	if o.HasValue() {
+		return other
+	}
+	return NilOption[T]()
+}
+
+// Xor returns the one of o and other that has a value if exactly one of them has a value,
+// returns an empty optional otherwise
+func (o *OptionImplem[T]) Xor(other Option[T]) Option[T] {
+	switch {
+	case o.HasValue() && !other.HasValue():
+		return o
+	case !o.HasValue() && other.HasValue():
+		return other
+	}
+	return NilOption[T]()
+}
+
+// OkOr returns a successful result containing the value of o, or a failed result containing err if o is empty
+func (o *OptionImplem[T]) OkOr(err error) Result[T] {
+	if o == nil {
+		return Err[T](err)
+	}
+	return Ok(o.content)
+}
+
 // OptionalMap returns an option value result from f(o.Value()) if o is not empty, returns an empty optional otherwise
+// If o is a *LazyOption, the result is a *LazyOption and o is not forced
 func OptionalMap[T1, T2 any](o Option[T1], f func(T1) T2) Option[T2] {
+	if l, ok := o.(*LazyOption[T1]); ok {
+		return NewLazyOption(func() Option[T2] { return OptionalMap(l.Force(), f) })
+	}
 	if o.HasValue() {
 		return NewOption(f(o.Value()))
 	}
@@ -61,7 +137,11 @@ func OptionalMap[T1, T2 any](o Option[T1], f func(T1) T2) Option[T2] {
 
 // OptionalFlatMap returns the result of f(o.Value()) if o has a value, returns an empty optional otherwise
 // Similar to OptionalMap but for function that already returns an optional type
+// If o is a *LazyOption, the result is a *LazyOption and o is not forced
 func OptionalFlatMap[T1, T2 any](o Option[T1], f func(T1) Option[T2]) Option[T2] {
+	if l, ok := o.(*LazyOption[T1]); ok {
+		return NewLazyOption(func() Option[T2] { return OptionalFlatMap(l.Force(), f) })
+	}
 	if o.HasValue() {
 		return f(o.Value())
 	}
@@ -77,3 +157,29 @@ func OptionalElse[T any](o Option[T], f func()) Option[T] {
 func OptionalDo[T any](o Option[T], f func(T)) Option[T] {
 	return o.Do(f)
 }
+
+// FilterOption returns o if it has a value satisfying pred, returns an empty optional otherwise
+func FilterOption[T any](o Option[T], pred func(T) bool) Option[T] {
+	if o.HasValue() && pred(o.Value()) {
+		return o
+	}
+	return NilOption[T]()
+}
+
+// OptionalZip returns an optional containing f(a.Value(), b.Value()) if both a and b have a value,
+// returns an empty optional otherwise
+func OptionalZip[T1, T2, T3 any](a Option[T1], b Option[T2], f func(T1, T2) T3) Option[T3] {
+	if a.HasValue() && b.HasValue() {
+		return NewOption(f(a.Value(), b.Value()))
+	}
+	return NilOption[T3]()
+}
+
+// Unzip splits the value of o in two using f, both results are empty if o is empty
+func Unzip[T, T1, T2 any](o Option[T], f func(T) (T1, T2)) (Option[T1], Option[T2]) {
+	if o.HasValue() {
+		a, b := f(o.Value())
+		return NewOption(a), NewOption(b)
+	}
+	return NilOption[T1](), NilOption[T2]()
+}
@@ -0,0 +1,72 @@
+package utils
+
+// FromOk returns an optional value containing v if ok is true, returns an empty optional otherwise
+// FromOk(m[k]) is not valid Go, use Lookup for maps
+func FromOk[T any](v T, ok bool) *OptionImplem[T] {
+	if !ok {
+		return NilOption[T]()
+	}
+	return NewOption(v)
+}
+
+// FromPtr returns an optional value containing *p, returns an empty optional if p is nil
+func FromPtr[T any](p *T) *OptionImplem[T] {
+	if p == nil {
+		return NilOption[T]()
+	}
+	return NewOption(*p)
+}
+
+// FromError returns an optional value containing v if err is nil, returns an empty optional otherwise
+// FromError(q.Front()) is empty if the deque q is empty
+func FromError[T any](v T, err error) *OptionImplem[T] {
+	return FromOk(v, err == nil)
+}
+
+// FromZero returns an optional value containing v, returns an empty optional if v is the zero value of T
+func FromZero[T comparable](v T) *OptionImplem[T] {
+	var zero T
+	return FromOk(v, v != zero)
+}
+
+// Lookup returns an optional value containing m[k], returns an empty optional if k is not in m
+// Lookup is the Option-returning form of a map access, there is no separate map Get helper
+func Lookup[K comparable, V any](m map[K]V, k K) *OptionImplem[V] {
+	v, ok := m[k]
+	return FromOk(v, ok)
+}
+
+// At returns an optional value containing s[i], returns an empty optional if i is out of range
+func At[T any](s []T, i int) *OptionImplem[T] {
+	if i < 0 || i >= len(s) {
+		return NilOption[T]()
+	}
+	return NewOption(s[i])
+}
+
+// FirstOf returns an optional value containing the first element of s, returns an empty optional if s is empty
+func FirstOf[T any](s []T) *OptionImplem[T] {
+	return At(s, 0)
+}
+
+// LastOf returns an optional value containing the last element of s, returns an empty optional if s is empty
+func LastOf[T any](s []T) *OptionImplem[T] {
+	return At(s, len(s)-1)
+}
+
+// ToPtr returns a pointer to a copy of the content of o, returns nil if o is empty
+func (o *OptionImplem[T]) ToPtr() *T {
+	if o == nil {
+		return nil
+	}
+	v := o.content
+	return &v
+}
+
+// ToPtr returns a pointer to a copy of the content of o, returns nil if o is empty
+func (o Opt[T]) ToPtr() *T {
+	if !o.ok {
+		return nil
+	}
+	return &o.value
+}
@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionConstructors(t *testing.T) {
+	answer := 42
+	m := map[string]int{"answer": 42, "zero": 0}
+	s := []int{42, 1, 2, 3, 42}
+	type testCases struct {
+		name     string
+		opt      Option[int]
+		hasValue bool
+	}
+	cases := []testCases{
+		{name: "FromOk true", opt: FromOk(42, true), hasValue: true},
+		{name: "FromOk false", opt: FromOk(42, false), hasValue: false},
+		{name: "FromPtr", opt: FromPtr(&answer), hasValue: true},
+		{name: "FromPtr nil", opt: FromPtr[int](nil), hasValue: false},
+		{name: "FromError without error", opt: FromError(42, nil), hasValue: true},
+		{name: "FromError with error", opt: FromError(42, errors.New("error")), hasValue: false},
+		{name: "FromZero", opt: FromZero(42), hasValue: true},
+		{name: "FromZero zero", opt: FromZero(0), hasValue: false},
+		{name: "Lookup", opt: Lookup(m, "answer"), hasValue: true},
+		{name: "Lookup zero value", opt: Lookup(m, "zero"), hasValue: true},
+		{name: "Lookup missing key", opt: Lookup(m, "missing"), hasValue: false},
+		{name: "FirstOf", opt: FirstOf(s), hasValue: true},
+		{name: "FirstOf empty slice", opt: FirstOf([]int{}), hasValue: false},
+		{name: "LastOf", opt: LastOf(s), hasValue: true},
+		{name: "LastOf nil slice", opt: LastOf[int](nil), hasValue: false},
+		{name: "At", opt: At(s, 4), hasValue: true},
+		{name: "At out of range", opt: At(s, 5), hasValue: false},
+		{name: "At negative", opt: At(s, -1), hasValue: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.hasValue, tt.opt.HasValue())
+			if tt.hasValue {
+				v := tt.opt.Value()
+				if v != 0 {
+					require.Equal(t, 42, v)
+				}
+			}
+		})
+	}
+}
+
+func TestToPtr(t *testing.T) {
+	o := NewOption(42)
+	p := o.ToPtr()
+	require.NotNil(t, p)
+	require.Equal(t, 42, *p)
+	*p = 0
+	require.Equal(t, 42, o.Value())
+	require.Nil(t, NilOption[int]().ToPtr())
+	require.Equal(t, 42, *NewOpt(42).ToPtr())
+	require.Nil(t, NilOpt[int]().ToPtr())
+	require.Equal(t, 42, FromPtr(NewOption(42).ToPtr()).Value())
+}
+
+func TestConstructorsChain(t *testing.T) {
+	m := map[string]string{"a": "1", "b": "x"}
+	parse := func(s string) Option[int] { return FromError(strconv.Atoi(s)) }
+	require.Equal(t, 2, OptionalMap(OptionalFlatMap[string](Lookup(m, "a"), parse), func(n int) int { return 2 * n }).Value())
+	require.False(t, OptionalFlatMap[string](Lookup(m, "b"), parse).HasValue())
+	require.False(t, OptionalFlatMap[string](Lookup(m, "c"), parse).HasValue())
+}
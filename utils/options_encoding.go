@@ -0,0 +1,230 @@
+package utils
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gopkg.in/yaml.v3"
+)
+
+/*
+ * Encoding support for optional values: an empty optional value is encoded as null (JSON, YAML)
+ * or NULL (SQL), and conversely.
+ *
+ * Use *OptionImplem[T] or Opt[T] for fields, Option[T] being an interface it cannot be decoded.
+ * Since Value() is already taken by the Option interface, SQL support (driver.Valuer) is provided
+ * through the SQLOpt[T] wrapper.
+ * Two exceptions to "null decodes as empty": yaml.v3 leaves an Opt[T] unchanged on null, and SQL NULL
+ * cannot be scanned into a non-nil *OptionImplem[T], see UnmarshalYAML and Scan.
+ */
+
+// jsonNull is the JSON encoding of an empty optional value
+var jsonNull = []byte("null")
+
+// MarshalJSON implements json.Marshaler, a nil *OptionImplem is encoded as null and ignored with omitempty
+func (o *OptionImplem[T]) MarshalJSON() ([]byte, error) {
+	if o == nil {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.content)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+// For null, encoding/json sets the pointer to nil without calling UnmarshalJSON
+func (o *OptionImplem[T]) UnmarshalJSON(data []byte) error {
+	var content T
+	if !bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		if err := json.Unmarshal(data, &content); err != nil {
+			return err
+		}
+	}
+	o.content = content
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler
+func (o *OptionImplem[T]) MarshalYAML() (interface{}, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return o.content, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (o *OptionImplem[T]) UnmarshalYAML(node *yaml.Node) error {
+	var content T
+	if err := node.Decode(&content); err != nil {
+		return err
+	}
+	o.content = content
+	return nil
+}
+
+// errScanNull is returned when scanning NULL into a non-nil *OptionImplem
+var errScanNull = errors.New("cannot scan NULL into a non-empty option, scan into a **OptionImplem or a SQLOpt")
+
+// Scan implements sql.Scanner, the receiver cannot be set to nil so scanning NULL fails
+// To scan nullable columns, pass a **OptionImplem[T] (database/sql sets it to nil on NULL) or use SQLOpt[T]
+func (o *OptionImplem[T]) Scan(src any) error {
+	if src == nil {
+		return errScanNull
+	}
+	var content T
+	if err := convertAssign(&content, src); err != nil {
+		return err
+	}
+	o.content = content
+	return nil
+}
+
+// IsZero returns true if o is empty, used by yaml to omit empty fields tagged with omitempty
+// encoding/json ignores omitempty on struct types, so an empty Opt field is always encoded as null, use a
+// *OptionImplem field when the key must be omitted from the JSON output
+func (o Opt[T]) IsZero() bool {
+	return !o.ok
+}
+
+// MarshalJSON implements json.Marshaler, an empty Opt is encoded as null
+func (o Opt[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, null is decoded as an empty Opt
+func (o *Opt[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = NilOpt[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = NewOpt(value)
+	return nil
+}
+
+// MarshalYAML implements yaml.Marshaler, an empty Opt is encoded as null
+func (o Opt[T]) MarshalYAML() (interface{}, error) {
+	if !o.ok {
+		return nil, nil
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+// yaml.v3 never calls unmarshalers on null and does not reset struct values, so an explicit null leaves
+// the Opt unchanged: decode into a zero Opt (an absent or null key then gives an empty Opt), or use a
+// *OptionImplem field that yaml.v3 sets to nil
+func (o *Opt[T]) UnmarshalYAML(node *yaml.Node) error {
+	var value T
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	*o = NewOpt(value)
+	return nil
+}
+
+// SQLOpt[T] wraps an Opt[T] to implement sql.Scanner and driver.Valuer, NULL is mapped to an empty Opt
+type SQLOpt[T any] struct {
+	Opt[T]
+}
+
+// Scan implements sql.Scanner
+func (o *SQLOpt[T]) Scan(src any) error {
+	if src == nil {
+		o.Opt = NilOpt[T]()
+		return nil
+	}
+	var value T
+	if err := convertAssign(&value, src); err != nil {
+		return err
+	}
+	o.Opt = NewOpt(value)
+	return nil
+}
+
+// Value implements driver.Valuer
+func (o SQLOpt[T]) Value() (driver.Value, error) {
+	if !o.ok {
+		return nil, nil
+	}
+	if v, ok := any(o.value).(driver.Valuer); ok {
+		return v.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// convertAssign stores src, a value returned by a SQL driver, in dest
+func convertAssign[T any](dest *T, src any) error {
+	if s, ok := any(dest).(sql.Scanner); ok {
+		return s.Scan(src)
+	}
+	if v, ok := src.(T); ok {
+		*dest = v
+		return nil
+	}
+	dv := reflect.ValueOf(dest).Elem()
+	sv := reflect.ValueOf(src)
+	switch {
+	case isNumber(sv.Kind()) && isNumber(dv.Kind()):
+		if !fits(sv, dv.Type()) {
+			return fmt.Errorf("converting %v to %s: value out of range", src, dv.Type())
+		}
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	case isText(sv.Type()) && isText(dv.Type()):
+		if sv.Kind() == reflect.Slice && dv.Kind() == reflect.Slice {
+			// drivers may reuse their buffers
+			sv = reflect.ValueOf(append([]byte{}, sv.Bytes()...))
+		}
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	case sv.Kind() == reflect.Bool && dv.Kind() == reflect.Bool:
+		dv.SetBool(sv.Bool())
+		return nil
+	}
+	return fmt.Errorf("unsupported conversion from %T to %s", src, dv.Type())
+}
+
+// isNumber returns true for integer and floating point kinds
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// isText returns true for strings and byte slices
+func isText(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+}
+
+// fits returns true if the number v can be converted to t without overflow
+func fits(v reflect.Value, t reflect.Type) bool {
+	c := reflect.Zero(t)
+	switch {
+	case c.CanInt() && v.CanInt():
+		return !c.OverflowInt(v.Int())
+	case c.CanInt() && v.CanUint():
+		return v.Uint() <= 1<<63-1 && !c.OverflowInt(int64(v.Uint()))
+	case c.CanUint() && v.CanInt():
+		return v.Int() >= 0 && !c.OverflowUint(uint64(v.Int()))
+	case c.CanUint() && v.CanUint():
+		return !c.OverflowUint(v.Uint())
+	case c.CanFloat() && v.CanFloat():
+		return !c.OverflowFloat(v.Float())
+	}
+	// conversions between integers and floating point numbers are allowed
+	return true
+}
@@ -0,0 +1,315 @@
+package utils
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type encodedRecord struct {
+	Ptr      *OptionImplem[int] `json:"ptr,omitempty" yaml:"ptr,omitempty"`
+	Name     Opt[string]        `json:"name" yaml:"name"`
+	Count    Opt[int]           `json:"count" yaml:"count,omitempty"`
+	Children Opt[[]int]         `json:"children" yaml:"children"`
+}
+
+func TestOptionJSON(t *testing.T) {
+	type testCases struct {
+		name    string
+		record  encodedRecord
+		encoded string
+	}
+	cases := []testCases{
+		{
+			name:    "empty values",
+			record:  encodedRecord{},
+			encoded: `{"name":null,"count":null,"children":null}`,
+		},
+		{
+			name:    "all values",
+			record:  encodedRecord{Ptr: NewOption(1), Name: NewOpt("a"), Count: NewOpt(0), Children: NewOpt([]int{1, 2})},
+			encoded: `{"ptr":1,"name":"a","count":0,"children":[1,2]}`,
+		},
+		{
+			name:    "zero values are not empty",
+			record:  encodedRecord{Ptr: NewOption(0), Name: NewOpt(""), Children: NewOpt([]int{})},
+			encoded: `{"ptr":0,"name":"","count":null,"children":[]}`,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.record)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.encoded, string(data))
+			var decoded encodedRecord
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			require.Equal(t, tt.record, decoded)
+		})
+	}
+	t.Run("explicit null", func(t *testing.T) {
+		decoded := encodedRecord{Ptr: NewOption(1), Name: NewOpt("a")}
+		require.NoError(t, json.Unmarshal([]byte(`{"ptr":null,"name":null}`), &decoded))
+		require.False(t, decoded.Ptr.HasValue())
+		require.False(t, decoded.Name.HasValue())
+	})
+	t.Run("invalid content", func(t *testing.T) {
+		var decoded encodedRecord
+		require.Error(t, json.Unmarshal([]byte(`{"count":"a"}`), &decoded))
+		require.Error(t, json.Unmarshal([]byte(`{"ptr":"a"}`), &decoded))
+	})
+	t.Run("omitempty", func(t *testing.T) {
+		// encoding/json only omits the nil pointer, an empty Opt is still encoded as null
+		record := struct {
+			Ptr *OptionImplem[int] `json:"ptr,omitempty"`
+			Opt Opt[int]           `json:"opt,omitempty"`
+		}{}
+		data, err := json.Marshal(record)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"opt":null}`, string(data))
+	})
+}
+
+func TestOptionYAML(t *testing.T) {
+	type testCases struct {
+		name    string
+		record  encodedRecord
+		encoded string
+	}
+	cases := []testCases{
+		{
+			name:    "empty values",
+			record:  encodedRecord{},
+			encoded: "name: null\nchildren: null\n",
+		},
+		{
+			name:    "all values",
+			record:  encodedRecord{Ptr: NewOption(1), Name: NewOpt("a"), Count: NewOpt(0), Children: NewOpt([]int{1, 2})},
+			encoded: "ptr: 1\nname: a\ncount: 0\nchildren:\n    - 1\n    - 2\n",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := yaml.Marshal(tt.record)
+			require.NoError(t, err)
+			require.Equal(t, tt.encoded, string(data))
+			var decoded encodedRecord
+			require.NoError(t, yaml.Unmarshal(data, &decoded))
+			require.Equal(t, tt.record, decoded)
+		})
+	}
+	t.Run("invalid content", func(t *testing.T) {
+		var decoded encodedRecord
+		require.Error(t, yaml.Unmarshal([]byte("count: a\n"), &decoded))
+		require.Error(t, yaml.Unmarshal([]byte("ptr: a\n"), &decoded))
+	})
+	t.Run("explicit null", func(t *testing.T) {
+		// yaml.v3 resets the pointer but skips the Opt value
+		decoded := encodedRecord{Ptr: NewOption(1), Name: NewOpt("a")}
+		require.NoError(t, yaml.Unmarshal([]byte("ptr: null\nname: null\n"), &decoded))
+		require.False(t, decoded.Ptr.HasValue())
+		require.Equal(t, NewOpt("a"), decoded.Name)
+	})
+}
+
+/*
+ * Minimal in-memory database/sql driver: every Exec appends its arguments as a row in a single
+ * table, every Query returns all stored rows.
+ */
+
+type memoryDriver struct {
+	sync.Mutex
+	rows [][]driver.Value
+}
+
+type memoryConn struct {
+	d *memoryDriver
+}
+
+type memoryStmt struct {
+	d     *memoryDriver
+	query string
+}
+
+type memoryRows struct {
+	rows [][]driver.Value
+	pos  int
+}
+
+func (d *memoryDriver) Open(string) (driver.Conn, error) {
+	return memoryConn{d: d}, nil
+}
+
+func (c memoryConn) Prepare(query string) (driver.Stmt, error) {
+	return memoryStmt{d: c.d, query: query}, nil
+}
+
+func (c memoryConn) Close() error {
+	return nil
+}
+
+func (c memoryConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+func (s memoryStmt) Close() error {
+	return nil
+}
+
+func (s memoryStmt) NumInput() int {
+	return -1
+}
+
+func (s memoryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.Lock()
+	defer s.d.Unlock()
+	s.d.rows = append(s.d.rows, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s memoryStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.d.Lock()
+	defer s.d.Unlock()
+	return &memoryRows{rows: append([][]driver.Value{}, s.d.rows...)}, nil
+}
+
+func (r *memoryRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *memoryRows) Close() error {
+	return nil
+}
+
+func (r *memoryRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+var (
+	registerMemoryDriver sync.Once
+	memoryDBCount        atomic.Int64
+)
+
+// openMemoryDB opens a new empty memory database
+func openMemoryDB(t *testing.T) *sql.DB {
+	registerMemoryDriver.Do(func() {
+		sql.Register("utils-memory", &driverByName{drivers: map[string]*memoryDriver{}})
+	})
+	name := fmt.Sprintf("%s-%d", t.Name(), memoryDBCount.Add(1))
+	db, err := sql.Open("utils-memory", name)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// driverByName dispatches connections to a separate memory database per data source name
+type driverByName struct {
+	sync.Mutex
+	drivers map[string]*memoryDriver
+}
+
+func (d *driverByName) Open(name string) (driver.Conn, error) {
+	d.Lock()
+	defer d.Unlock()
+	m, ok := d.drivers[name]
+	if !ok {
+		m = &memoryDriver{}
+		d.drivers[name] = m
+	}
+	return m.Open(name)
+}
+
+func TestOptionSQL(t *testing.T) {
+	db := openMemoryDB(t)
+	type record struct {
+		id    SQLOpt[int32]
+		name  SQLOpt[string]
+		ratio SQLOpt[float64]
+		blob  SQLOpt[[]byte]
+	}
+	records := []record{
+		{},
+		{id: SQLOpt[int32]{NewOpt[int32](1)}, name: SQLOpt[string]{NewOpt("a")}},
+		{id: SQLOpt[int32]{NewOpt[int32](0)}, ratio: SQLOpt[float64]{NewOpt(0.5)}, blob: SQLOpt[[]byte]{NewOpt([]byte("b"))}},
+	}
+	for _, r := range records {
+		_, err := db.Exec("insert", r.id, r.name, r.ratio, r.blob)
+		require.NoError(t, err)
+	}
+	rows, err := db.Query("select")
+	require.NoError(t, err)
+	defer rows.Close()
+	var decoded []record
+	for rows.Next() {
+		var r record
+		require.NoError(t, rows.Scan(&r.id, &r.name, &r.ratio, &r.blob))
+		decoded = append(decoded, r)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, records, decoded)
+
+	// database/sql maps NULL to a nil *OptionImplem
+	rows, err = db.Query("select")
+	require.NoError(t, err)
+	defer rows.Close()
+	var names []*OptionImplem[string]
+	for rows.Next() {
+		var id, ratio, blob any
+		var name *OptionImplem[string]
+		require.NoError(t, rows.Scan(&id, &name, &ratio, &blob))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	require.Equal(t, []*OptionImplem[string]{nil, NewOption("a"), nil}, names)
+}
+
+func TestOptionScan(t *testing.T) {
+	t.Run("SQLOpt conversions", func(t *testing.T) {
+		var i SQLOpt[int]
+		require.NoError(t, i.Scan(int64(42)))
+		require.Equal(t, 42, i.Opt.Value())
+		require.NoError(t, i.Scan(nil))
+		require.False(t, i.HasValue())
+		var s SQLOpt[string]
+		require.NoError(t, s.Scan([]byte("text")))
+		require.Equal(t, "text", s.Opt.Value())
+		var b SQLOpt[bool]
+		require.NoError(t, b.Scan(true))
+		require.True(t, b.Opt.Value())
+		var n SQLOpt[sql.NullInt64]
+		require.NoError(t, n.Scan(int64(1)))
+		require.Equal(t, sql.NullInt64{Int64: 1, Valid: true}, n.Opt.Value())
+	})
+	t.Run("SQLOpt invalid conversions", func(t *testing.T) {
+		var i SQLOpt[int8]
+		require.Error(t, i.Scan(int64(1000)))
+		var u SQLOpt[uint]
+		require.Error(t, u.Scan(int64(-1)))
+		var s SQLOpt[string]
+		require.Error(t, s.Scan(int64(65)))
+		require.False(t, s.HasValue())
+	})
+	t.Run("OptionImplem", func(t *testing.T) {
+		o := NewOption(0)
+		require.NoError(t, o.Scan(int64(42)))
+		require.Equal(t, 42, o.Value())
+		require.Error(t, o.Scan(nil))
+		require.Equal(t, 42, o.Value())
+	})
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+
+	"golang.org/x/exp/constraints"
+)
+
+// typeName returns the name of T as written in Go code, %T cannot be used since it prints <nil> for the zero
+// value of an interface type
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// String implements fmt.Stringer, returns Some(v) or None
+func (o *OptionImplem[T]) String() string {
+	if o == nil {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.content)
+}
+
+// GoString implements fmt.GoStringer, returns the Go expression building o
+func (o *OptionImplem[T]) GoString() string {
+	if o == nil {
+		return fmt.Sprintf("utils.NilOption[%s]()", typeName[T]())
+	}
+	return fmt.Sprintf("utils.NewOption(%#v)", o.content)
+}
+
+// String implements fmt.Stringer, returns Some(v) or None
+func (o Opt[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// GoString implements fmt.GoStringer, returns the Go expression building o
+func (o Opt[T]) GoString() string {
+	if !o.ok {
+		return fmt.Sprintf("utils.NilOpt[%s]()", typeName[T]())
+	}
+	return fmt.Sprintf("utils.NewOpt(%#v)", o.value)
+}
+
+// String implements fmt.Stringer, forces o and returns the string of its content, see OptionImplem.String
+func (o *LazyOption[T]) String() string {
+	return fmt.Sprint(o.Force())
+}
+
+// GoString implements fmt.GoStringer, forces o and returns the Go expression building its content
+func (o *LazyOption[T]) GoString() string {
+	return fmt.Sprintf("%#v", o.Force())
+}
+
+// String implements fmt.Stringer, returns Ok(v) or Err(error message)
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%s)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.value)
+}
+
+// GoString implements fmt.GoStringer, returns the Go expression building r, errors are rebuilt from their
+// message with errors.New so the original error type is lost
+func (r Result[T]) GoString() string {
+	if r.err != nil {
+		return fmt.Sprintf("utils.Err[%s](errors.New(%q))", typeName[T](), r.err.Error())
+	}
+	return fmt.Sprintf("utils.Ok(%#v)", r.value)
+}
+
+// OptionEqual returns true if a and b are both empty or both contain the same value
+func OptionEqual[T comparable](a, b Option[T]) bool {
+	if a.HasValue() != b.HasValue() {
+		return false
+	}
+	return !a.HasValue() || a.Value() == b.Value()
+}
+
+// OptionCompare compares a and b, an empty optional is smaller than any non-empty optional
+// returns -1 if a is smaller than b, 0 if they are equal and +1 if a is greater than b
+func OptionCompare[T constraints.Ordered](a, b Option[T]) int {
+	switch {
+	case !a.HasValue() && !b.HasValue():
+		return 0
+	case !a.HasValue():
+		return -1
+	case !b.HasValue():
+		return 1
+	case a.Value() < b.Value():
+		return -1
+	case b.Value() < a.Value():
+		return 1
+	}
+	return 0
+}
+
+// OptionKey returns a comparable representation of o, two optional values have the same key if
+// and only if OptionEqual returns true, so keys can be used in maps
+func OptionKey[T comparable](o Option[T]) Opt[T] {
+	return FromOption(o)
+}
+
+// ResultEqual returns true if a and b both contain the same value, or both contain errors e1 and e2
+// such that errors.Is(e1, e2) or errors.Is(e2, e1), so ResultEqual(a, b) == ResultEqual(b, a)
+func ResultEqual[T comparable](a, b Result[T]) bool {
+	if a.IsErr() || b.IsErr() {
+		return a.IsErr() && b.IsErr() && (a.Is(b.err) || b.Is(a.err))
+	}
+	return a.value == b.value
+}
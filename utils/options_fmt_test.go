@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionFormat(t *testing.T) {
+	type testCases struct {
+		name     string
+		value    any
+		str      string
+		goString string
+	}
+	cases := []testCases{
+		{name: "Some option", value: NewOption(3), str: "Some(3)", goString: "utils.NewOption(3)"},
+		{name: "None option", value: NilOption[int](), str: "None", goString: "utils.NilOption[int]()"},
+		{name: "Some string", value: NewOption("a"), str: "Some(a)", goString: `utils.NewOption("a")`},
+		{name: "Some opt", value: NewOpt(3), str: "Some(3)", goString: "utils.NewOpt(3)"},
+		{name: "None opt", value: NilOpt[string](), str: "None", goString: "utils.NilOpt[string]()"},
+		{name: "Some lazy", value: NewLazyOption(func() Option[int] { return NewOption(3) }), str: "Some(3)", goString: "utils.NewOption(3)"},
+		{name: "None lazy", value: NewLazyOption(func() Option[int] { return NilOption[int]() }), str: "None", goString: "utils.NilOption[int]()"},
+		{name: "Ok result", value: Ok(3), str: "Ok(3)", goString: "utils.Ok(3)"},
+		{name: "None interface option", value: NilOption[error](), str: "None", goString: "utils.NilOption[error]()"},
+		{name: "None interface opt", value: NilOpt[fmt.Stringer](), str: "None", goString: "utils.NilOpt[fmt.Stringer]()"},
+		{
+			name:     "Err interface result",
+			value:    Err[fmt.Stringer](errTest),
+			str:      "Err(test error)",
+			goString: `utils.Err[fmt.Stringer](errors.New("test error"))`,
+		},
+		{name: "Err result", value: Err[int](errTest), str: "Err(test error)", goString: `utils.Err[int](errors.New("test error"))`},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.str, fmt.Sprint(tt.value))
+			require.Equal(t, tt.str, fmt.Sprintf("%v", tt.value))
+			require.Equal(t, tt.goString, fmt.Sprintf("%#v", tt.value))
+		})
+	}
+}
+
+func TestOptionEqual(t *testing.T) {
+	type testCases struct {
+		name    string
+		a       Option[int]
+		b       Option[int]
+		equal   bool
+		compare int
+	}
+	cases := []testCases{
+		{name: "None, None", a: NilOption[int](), b: NilOption[int](), equal: true, compare: 0},
+		{name: "None, Some", a: NilOption[int](), b: NewOption(0), equal: false, compare: -1},
+		{name: "Some, None", a: NewOption(0), b: NilOption[int](), equal: false, compare: 1},
+		{name: "same value", a: NewOption(1), b: NewOption(1), equal: true, compare: 0},
+		{name: "smaller value", a: NewOption(1), b: NewOption(2), equal: false, compare: -1},
+		{name: "greater value", a: NewOption(3), b: NewOption(2), equal: false, compare: 1},
+		{name: "Option and Opt", a: NewOption(3), b: NewOpt(3).ToOption(), equal: true, compare: 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.equal, OptionEqual(tt.a, tt.b))
+			require.Equal(t, tt.compare, OptionCompare(tt.a, tt.b))
+			require.Equal(t, -tt.compare, OptionCompare(tt.b, tt.a))
+			require.Equal(t, tt.equal, OptionKey(tt.a) == OptionKey(tt.b))
+		})
+	}
+}
+
+func TestOptionKey(t *testing.T) {
+	m := map[Opt[int]]string{}
+	m[OptionKey[int](NewOption(1))] = "one"
+	m[OptionKey[int](NilOption[int]())] = "none"
+	m[OptionKey[int](NewOption(1))] = "one again"
+	require.Len(t, m, 2)
+	require.Equal(t, "one again", m[NewOpt(1)])
+	require.Equal(t, "none", m[NilOpt[int]()])
+	require.Equal(t, "none", m[Opt[int]{}])
+}
+
+func TestResultEqual(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", errTest)
+	require.True(t, ResultEqual(Ok(1), Ok(1)))
+	require.False(t, ResultEqual(Ok(1), Ok(2)))
+	require.False(t, ResultEqual(Ok(1), Err[int](errTest)))
+	require.False(t, ResultEqual(Err[int](errTest), Ok(1)))
+	require.True(t, ResultEqual(Err[int](errTest), Err[int](errTest)))
+	require.True(t, ResultEqual(Err[int](wrapped), Err[int](errTest)))
+	require.True(t, ResultEqual(Err[int](errTest), Err[int](wrapped)))
+	require.False(t, ResultEqual(Err[int](errTest), Err[int](errors.New("other"))))
+}
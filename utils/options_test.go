@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -109,3 +110,98 @@ func TestDo(t *testing.T) {
 		require.False(t, r.HasValue())
 	})
 }
+
+func TestValueOr(t *testing.T) {
+	type testCases struct {
+		name     string
+		opt      Option[int]
+		expected int
+		zero     int
+	}
+	cases := []testCases{
+		{name: "nil option", opt: NilOption[int](), expected: -1, zero: 0},
+		{name: "some value", opt: NewOption(42), expected: 42, zero: 42},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			f := func() int {
+				called = true
+				return -1
+			}
+			require.Equal(t, tt.expected, tt.opt.ValueOr(-1))
+			require.Equal(t, tt.expected, tt.opt.ValueOrElse(f))
+			require.Equal(t, !tt.opt.HasValue(), called)
+			require.Equal(t, tt.zero, tt.opt.ValueOrZero())
+			v, ok := tt.opt.Get()
+			require.Equal(t, tt.opt.HasValue(), ok)
+			require.Equal(t, tt.zero, v)
+		})
+	}
+}
+
+func TestOptionCombinators(t *testing.T) {
+	some2, some3, none := NewOption(2), NewOption(3), NilOption[int]()
+	type testCases struct {
+		name  string
+		left  Option[int]
+		right Option[int]
+		or    Option[int]
+		and   Option[int]
+		xor   Option[int]
+	}
+	cases := []testCases{
+		{name: "Some(2), None", left: some2, right: none, or: some2, and: none, xor: some2},
+		{name: "None, Some(3)", left: none, right: some3, or: some3, and: none, xor: some3},
+		{name: "Some(2), Some(3)", left: some2, right: some3, or: some2, and: some3, xor: none},
+		{name: "None, None", left: none, right: none, or: none, and: none, xor: none},
+	}
+	check := func(t *testing.T, expected, r Option[int]) {
+		require.Equal(t, expected.HasValue(), r.HasValue())
+		if expected.HasValue() {
+			require.Equal(t, expected.Value(), r.Value())
+		}
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			check(t, tt.or, tt.left.OrElse(tt.right))
+			check(t, tt.and, tt.left.And(tt.right))
+			check(t, tt.xor, tt.left.Xor(tt.right))
+		})
+	}
+}
+
+func TestOkOr(t *testing.T) {
+	errEmpty := errors.New("empty")
+	r := NewOption(42).OkOr(errEmpty)
+	require.True(t, r.IsOk())
+	require.Equal(t, 42, r.Unwrap())
+	r = NilOption[int]().OkOr(errEmpty)
+	require.True(t, r.Is(errEmpty))
+}
+
+func TestFilterOption(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	require.False(t, FilterOption[int](NilOption[int](), even).HasValue())
+	require.False(t, FilterOption[int](NewOption(3), even).HasValue())
+	require.Equal(t, 4, FilterOption[int](NewOption(4), even).Value())
+}
+
+func TestOptionalZip(t *testing.T) {
+	type pair struct {
+		n int
+		s string
+	}
+	mk := func(n int, s string) pair { return pair{n: n, s: s} }
+	require.Equal(t, pair{1, "a"}, OptionalZip[int, string](NewOption(1), NewOption("a"), mk).Value())
+	require.False(t, OptionalZip[int, string](NewOption(1), NilOption[string](), mk).HasValue())
+	require.False(t, OptionalZip[int, string](NilOption[int](), NewOption("a"), mk).HasValue())
+
+	split := func(p pair) (int, string) { return p.n, p.s }
+	n, s := Unzip[pair](NewOption(pair{1, "a"}), split)
+	require.Equal(t, 1, n.Value())
+	require.Equal(t, "a", s.Value())
+	n, s = Unzip[pair](NilOption[pair](), split)
+	require.False(t, n.HasValue())
+	require.False(t, s.HasValue())
+}
@@ -0,0 +1,89 @@
+// Package optiontest provides testify-style assertions on optional values and results
+//
+// Assert* functions report failures and return false, Require* functions stop the test on failure.
+package optiontest
+
+import (
+	"fmt"
+
+	"github.com/slashvar/go-toolbox/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tHelper is implemented by *testing.T to mark helper functions
+type tHelper interface {
+	Helper()
+}
+
+// helper marks the caller as a test helper when t supports it
+func helper(t assert.TestingT) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+}
+
+// AssertSome asserts that o contains expected
+func AssertSome[T any](t assert.TestingT, expected T, o utils.Option[T], msgAndArgs ...interface{}) bool {
+	helper(t)
+	if !o.HasValue() {
+		return assert.Fail(t, fmt.Sprintf("Should be Some(%#v), got None", expected), msgAndArgs...)
+	}
+	return assert.Equal(t, expected, o.Value(), msgAndArgs...)
+}
+
+// AssertNone asserts that o is empty
+func AssertNone[T any](t assert.TestingT, o utils.Option[T], msgAndArgs ...interface{}) bool {
+	helper(t)
+	if o.HasValue() {
+		return assert.Fail(t, fmt.Sprintf("Should be None, got Some(%#v)", o.Value()), msgAndArgs...)
+	}
+	return true
+}
+
+// AssertOk asserts that r is successful and contains expected
+func AssertOk[T any](t assert.TestingT, expected T, r utils.Result[T], msgAndArgs ...interface{}) bool {
+	helper(t)
+	if !assert.NoError(t, r.Err(), msgAndArgs...) {
+		return false
+	}
+	return assert.Equal(t, expected, r.Unwrap(), msgAndArgs...)
+}
+
+// AssertErr asserts that r holds an error matching target, see errors.Is
+func AssertErr[T any](t assert.TestingT, target error, r utils.Result[T], msgAndArgs ...interface{}) bool {
+	helper(t)
+	return assert.ErrorIs(t, r.Err(), target, msgAndArgs...)
+}
+
+// RequireSome requires that o contains expected
+func RequireSome[T any](t require.TestingT, expected T, o utils.Option[T], msgAndArgs ...interface{}) {
+	helper(t)
+	if !AssertSome(t, expected, o, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNone requires that o is empty
+func RequireNone[T any](t require.TestingT, o utils.Option[T], msgAndArgs ...interface{}) {
+	helper(t)
+	if !AssertNone(t, o, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireOk requires that r is successful and contains expected
+func RequireOk[T any](t require.TestingT, expected T, r utils.Result[T], msgAndArgs ...interface{}) {
+	helper(t)
+	if !AssertOk(t, expected, r, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireErr requires that r holds an error matching target, see errors.Is
+func RequireErr[T any](t require.TestingT, target error, r utils.Result[T], msgAndArgs ...interface{}) {
+	helper(t)
+	if !AssertErr(t, target, r, msgAndArgs...) {
+		t.FailNow()
+	}
+}
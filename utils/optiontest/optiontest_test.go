@@ -0,0 +1,77 @@
+package optiontest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/slashvar/go-toolbox/utils"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder is a TestingT recording failures instead of failing the test
+type recorder struct {
+	errors  []string
+	stopped bool
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) FailNow() {
+	r.stopped = true
+}
+
+var errTest = errors.New("test error")
+
+func TestAssertions(t *testing.T) {
+	type testCases struct {
+		name  string
+		check func(r *recorder) bool
+		pass  bool
+	}
+	cases := []testCases{
+		{name: "AssertSome on Some", check: func(r *recorder) bool { return AssertSome[int](r, 1, utils.NewOption(1)) }, pass: true},
+		{name: "AssertSome on other value", check: func(r *recorder) bool { return AssertSome[int](r, 1, utils.NewOption(2)) }, pass: false},
+		{name: "AssertSome on None", check: func(r *recorder) bool { return AssertSome[int](r, 1, utils.NilOption[int]()) }, pass: false},
+		{name: "AssertNone on None", check: func(r *recorder) bool { return AssertNone[int](r, utils.NilOption[int]()) }, pass: true},
+		{name: "AssertNone on Some", check: func(r *recorder) bool { return AssertNone[int](r, utils.NewOption(1)) }, pass: false},
+		{name: "AssertOk on Ok", check: func(r *recorder) bool { return AssertOk(r, 1, utils.Ok(1)) }, pass: true},
+		{name: "AssertOk on other value", check: func(r *recorder) bool { return AssertOk(r, 1, utils.Ok(2)) }, pass: false},
+		{name: "AssertOk on Err", check: func(r *recorder) bool { return AssertOk(r, 1, utils.Err[int](errTest)) }, pass: false},
+		{name: "AssertErr on Err", check: func(r *recorder) bool { return AssertErr(r, errTest, utils.Err[int](errTest)) }, pass: true},
+		{name: "AssertErr on other error", check: func(r *recorder) bool { return AssertErr(r, errTest, utils.Err[int](errors.New("other"))) }, pass: false},
+		{name: "AssertErr on Ok", check: func(r *recorder) bool { return AssertErr(r, errTest, utils.Ok(1)) }, pass: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			require.Equal(t, tt.pass, tt.check(r))
+			require.Equal(t, tt.pass, len(r.errors) == 0)
+		})
+	}
+}
+
+func TestRequirements(t *testing.T) {
+	r := &recorder{}
+	RequireSome[int](r, 1, utils.NewOption(1))
+	RequireNone[int](r, utils.NilOption[int]())
+	RequireOk(r, 1, utils.Ok(1))
+	RequireErr(r, errTest, utils.Err[int](errTest))
+	require.False(t, r.stopped)
+	require.Empty(t, r.errors)
+
+	failures := []func(r *recorder){
+		func(r *recorder) { RequireSome[int](r, 1, utils.NilOption[int]()) },
+		func(r *recorder) { RequireNone[int](r, utils.NewOption(1)) },
+		func(r *recorder) { RequireOk(r, 1, utils.Err[int](errTest)) },
+		func(r *recorder) { RequireErr(r, errTest, utils.Ok(1)) },
+	}
+	for _, f := range failures {
+		r := &recorder{}
+		f(r)
+		require.True(t, r.stopped)
+		require.NotEmpty(t, r.errors)
+	}
+}
@@ -0,0 +1,171 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+ * Parallel versions of slice helpers
+ *
+ * All functions take a context and a number of workers (GOMAXPROCS if workers <= 0). Results keep
+ * the order of the input and errors are deterministic: the error returned is the one of the
+ * smallest failing index, as it would be for a sequential traversal. A panic in a callback is
+ * recovered and raised again in the calling goroutine as a *PanicError, even if an error occurred
+ * at a smaller index; if several callbacks panic, the one with the smallest index is raised.
+ */
+
+// PanicError is the value re-panicked when a callback panics in a parallel helper
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+// Error implements error interface
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in parallel worker: %v\n%s", e.Value, e.Stack)
+}
+
+// parallelFor calls f(i) for all i in [0, n) using at most workers goroutines
+// Indices are processed in increasing order of start, once an index fails no greater index is started
+func parallelFor(ctx context.Context, workers, n int, f func(int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > n {
+		workers = n
+	}
+	var (
+		next      atomic.Int64
+		processed atomic.Int64
+		firstIdx  atomic.Int64
+		mu        sync.Mutex
+		firstErr  error
+		panicIdx  = n
+		panicked  *PanicError
+		wg        sync.WaitGroup
+	)
+	firstIdx.Store(int64(n))
+	fail := func(i int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if int64(i) < firstIdx.Load() {
+			firstIdx.Store(int64(i))
+			firstErr = err
+		}
+	}
+	call := func(i int) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				p := &PanicError{Value: r, Stack: debug.Stack()}
+				mu.Lock()
+				if i < panicIdx {
+					panicIdx, panicked = i, p
+				}
+				mu.Unlock()
+				err = p
+			}
+		}()
+		return f(i)
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				i := next.Add(1) - 1
+				if i >= int64(n) || i > firstIdx.Load() {
+					return
+				}
+				if err := call(int(i)); err != nil {
+					fail(int(i), err)
+				}
+				processed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	if panicked != nil {
+		panic(panicked)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if processed.Load() < int64(n) {
+		return ctx.Err()
+	}
+	return nil
+}
+
+// ParallelIter applies f to all elements of s using workers goroutines
+// returns the error of the first failing element in s, or the context error if ctx is done before completion
+func ParallelIter[T any](ctx context.Context, workers int, s []T, f func(T) error) error {
+	return parallelFor(ctx, workers, len(s), func(i int) error { return f(s[i]) })
+}
+
+// ParallelMap converts a slice of InType into a slice of OutType using function f and workers goroutines
+// returns the error of the first failing element in in, or the context error if ctx is done before completion
+func ParallelMap[InType, OutType any](ctx context.Context, workers int, in []InType, f func(InType) (OutType, error)) ([]OutType, error) {
+	r := make([]OutType, len(in))
+	err := parallelFor(ctx, workers, len(in), func(i int) error {
+		var err error
+		r[i], err = f(in[i])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ParallelFilter returns all the elements of s for which f returns true, in their original order
+// returns the error of the first failing element in s, or the context error if ctx is done before completion
+func ParallelFilter[T any](ctx context.Context, workers int, f func(T) (bool, error), s []T) ([]T, error) {
+	keep, err := ParallelMap(ctx, workers, s, f)
+	if err != nil {
+		return nil, err
+	}
+	var r []T
+	for i, e := range s {
+		if keep[i] {
+			r = append(r, e)
+		}
+	}
+	return r, nil
+}
+
+// ParallelAccumulate splits s in contiguous chunks, accumulates each chunk with f starting from init, and
+// combines the partial results from left to right
+// combine must be associative and init must be neutral for combine, so the result is the same as
+// Accumulate(f, init, s) when f(a, e) is combine(a, f(init, e))
+func ParallelAccumulate[InType, OutType any](ctx context.Context, workers int, f func(OutType, InType) (OutType, error), combine func(OutType, OutType) OutType, init OutType, s []InType) (OutType, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	chunks := workers
+	if chunks > len(s) {
+		chunks = len(s)
+	}
+	partial := make([]OutType, chunks)
+	err := parallelFor(ctx, workers, chunks, func(c int) error {
+		lo, hi := c*len(s)/chunks, (c+1)*len(s)/chunks
+		acc := init
+		for _, e := range s[lo:hi] {
+			var err error
+			if acc, err = f(acc, e); err != nil {
+				return err
+			}
+		}
+		partial[c] = acc
+		return nil
+	})
+	if err != nil {
+		var zero OutType
+		return zero, err
+	}
+	return Accumulate(combine, init, partial), nil
+}
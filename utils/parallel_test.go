@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// indexError is returned by failing callbacks in parallel tests
+type indexError struct {
+	index int
+}
+
+func (e indexError) Error() string {
+	return fmt.Sprintf("failure at %d", e.index)
+}
+
+func TestParallelMap(t *testing.T) {
+	type testCases struct {
+		name    string
+		in      []int
+		workers int
+		failAt  []int
+		err     error
+	}
+	cases := []testCases{
+		{name: "empty slice", in: []int{}, workers: 4},
+		{name: "default workers", in: buildSlice(1000, 1), workers: 0},
+		{name: "single worker", in: buildSlice(100, 1), workers: 1},
+		{name: "more workers than elements", in: buildSlice(3, 1), workers: 16},
+		{name: "one failure", in: buildSlice(1000, 1), workers: 8, failAt: []int{500}, err: indexError{500}},
+		{name: "first failure wins", in: buildSlice(1000, 1), workers: 8, failAt: []int{999, 10, 300}, err: indexError{10}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			double := func(n int) (int, error) {
+				for _, i := range tt.failAt {
+					if n == i {
+						return 0, indexError{i}
+					}
+				}
+				return 2 * n, nil
+			}
+			for run := 0; run < 10; run++ {
+				r, err := ParallelMap(context.Background(), tt.workers, tt.in, double)
+				if tt.err != nil {
+					require.Equal(t, tt.err, err)
+					require.Nil(t, r)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, Map(tt.in, func(n int) int { return 2 * n }), r)
+			}
+		})
+	}
+}
+
+func TestParallelIter(t *testing.T) {
+	var sum atomic.Int64
+	in := buildSlice(1000, 1)
+	err := ParallelIter(context.Background(), 8, in, func(n int) error {
+		sum.Add(int64(n))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(999*1000/2), sum.Load())
+	err = ParallelIter(context.Background(), 8, in, func(n int) error {
+		if n%100 == 99 {
+			return indexError{n}
+		}
+		return nil
+	})
+	require.Equal(t, indexError{99}, err)
+}
+
+func TestParallelFilter(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	in := shuffleSlice(1000, 1)
+	r, err := ParallelFilter(context.Background(), 8, func(n int) (bool, error) { return even(n), nil }, in)
+	require.NoError(t, err)
+	require.Equal(t, Filter(even, in), r)
+	_, err = ParallelFilter(context.Background(), 8, func(n int) (bool, error) { return false, errTest }, in)
+	require.ErrorIs(t, err, errTest)
+}
+
+func TestParallelAccumulate(t *testing.T) {
+	type testCases struct {
+		name    string
+		in      []int
+		workers int
+	}
+	cases := []testCases{
+		{name: "empty slice", in: []int{}, workers: 4},
+		{name: "fewer elements than workers", in: buildSlice(3, 1), workers: 8},
+		{name: "many elements", in: buildSlice(1001, 1), workers: 8},
+		{name: "default workers", in: buildSlice(1001, 1), workers: 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// concatenation is associative but not commutative, checks that order is preserved
+			appendTo := func(acc []int, n int) ([]int, error) { return append(acc, n), nil }
+			concat := func(a, b []int) []int { return append(append([]int{}, a...), b...) }
+			r, err := ParallelAccumulate(context.Background(), tt.workers, appendTo, concat, nil, tt.in)
+			require.NoError(t, err)
+			require.Equal(t, len(tt.in), len(r))
+			for i, e := range r {
+				require.Equal(t, tt.in[i], e)
+			}
+		})
+	}
+	failing := func(acc int, n int) (int, error) {
+		if n == 700 || n == 200 {
+			return 0, indexError{n}
+		}
+		return acc + n, nil
+	}
+	add := func(a, b int) int { return a + b }
+	_, err := ParallelAccumulate(context.Background(), 8, failing, add, 0, buildSlice(1000, 1))
+	require.Equal(t, indexError{200}, err)
+}
+
+func TestParallelContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := ParallelMap(ctx, 4, buildSlice(100, 1), func(n int) (int, error) { return n, nil })
+	require.ErrorIs(t, err, context.Canceled)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	var calls atomic.Int64
+	err = ParallelIter(ctx, 2, buildSlice(10000, 1), func(n int) error {
+		if calls.Add(1) == 10 {
+			cancel()
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, calls.Load(), int64(10000))
+
+	// context canceled after completion is not an error
+	ctx, cancel = context.WithCancel(context.Background())
+	r, err := ParallelMap(ctx, 2, buildSlice(10, 1), func(n int) (int, error) { return n, nil })
+	cancel()
+	require.NoError(t, err)
+	require.Equal(t, buildSlice(10, 1), r)
+}
+
+func TestParallelPanic(t *testing.T) {
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		p, ok := r.(*PanicError)
+		require.True(t, ok)
+		require.Equal(t, "boom", p.Value)
+		require.NotEmpty(t, p.Stack)
+		var err error = p
+		require.True(t, errors.As(err, &p))
+	}()
+	_ = ParallelIter(context.Background(), 4, buildSlice(100, 1), func(n int) error {
+		if n == 50 {
+			panic("boom")
+		}
+		return nil
+	})
+	require.Fail(t, "panic should be propagated")
+}
+
+func TestParallelPanicAfterError(t *testing.T) {
+	started := make(chan struct{})
+	f := func(n int) error {
+		switch n {
+		case 10:
+			// fail only once the panicking callback ran, the panic must not be hidden by the smaller index
+			<-started
+			return indexError{n}
+		case 50:
+			close(started)
+			panic("boom")
+		}
+		return nil
+	}
+	defer func() {
+		p, ok := recover().(*PanicError)
+		require.True(t, ok)
+		require.Equal(t, "boom", p.Value)
+	}()
+	_ = ParallelIter(context.Background(), 4, buildSlice(100, 1), f)
+	require.Fail(t, "panic should be propagated")
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Result[T] models the result of a computation that either produced a value of type T or failed with an error
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful result containing v
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed result containing err
+// panic if err is nil
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("building a failed result without error")
+	}
+	return Result[T]{err: err}
+}
+
+// FromPair builds a result from the usual (value, error) pair
+// FromPair(q.Front()) is a failed result if q is empty
+func FromPair[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Ok(v)
+}
+
+// IsOk returns true if r holds a value
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if r holds an error
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error held by r, nil if r is successful
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the content of r as a (value, error) pair
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Unwrap returns the value of r, panic if r holds an error
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("unwrap a failed result: %s", r.err))
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value of r or def if r holds an error
+func (r Result[T]) UnwrapOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+// MapErr returns r with its error replaced by f(r.Err()) if r holds an error, returns r otherwise
+// panic if f returns nil, a failed result cannot be turned into a successful one
+func (r Result[T]) MapErr(f func(error) error) Result[T] {
+	if r.err != nil {
+		return Err[T](f(r.err))
+	}
+	return r
+}
+
+// ToOption returns an optional value containing the value of r, the optional is empty if r holds an error
+func (r Result[T]) ToOption() Option[T] {
+	if r.err != nil {
+		return NilOption[T]()
+	}
+	return NewOption(r.value)
+}
+
+// Is reports whether the error held by r matches target, see errors.Is
+func (r Result[T]) Is(target error) bool {
+	return errors.Is(r.err, target)
+}
+
+// As finds the first error in the chain of r's error that matches target, see errors.As
+func (r Result[T]) As(target any) bool {
+	return r.err != nil && errors.As(r.err, target)
+}
+
+// ResultMap returns a result containing f(r.Unwrap()) if r is successful, returns r's error otherwise
+func ResultMap[T1, T2 any](r Result[T1], f func(T1) T2) Result[T2] {
+	if r.err != nil {
+		return Result[T2]{err: r.err}
+	}
+	return Ok(f(r.value))
+}
+
+// ResultFlatMap returns f(r.Unwrap()) if r is successful, returns r's error otherwise
+// Similar to ResultMap but for function that already returns a result
+func ResultFlatMap[T1, T2 any](r Result[T1], f func(T1) Result[T2]) Result[T2] {
+	if r.err != nil {
+		return Result[T2]{err: r.err}
+	}
+	return f(r.value)
+}
+
+// CollectResults returns the values of all results in s, or the first error found
+func CollectResults[T any](s []Result[T]) ([]T, error) {
+	r := make([]T, 0, len(s))
+	for _, e := range s {
+		if e.err != nil {
+			return nil, e.err
+		}
+		r = append(r, e.value)
+	}
+	return r, nil
+}
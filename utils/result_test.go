@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errTest = errors.New("test error")
+
+// testError is an error type used to check errors.As integration
+type testError struct {
+	code int
+}
+
+func (e testError) Error() string {
+	return fmt.Sprintf("error %d", e.code)
+}
+
+func TestResult(t *testing.T) {
+	type testCases struct {
+		name   string
+		result Result[int]
+		isOk   bool
+		value  int
+	}
+	cases := []testCases{
+		{name: "ok result", result: Ok(42), isOk: true, value: 42},
+		{name: "failed result", result: Err[int](errTest), isOk: false},
+		{name: "from successful pair", result: FromPair(42, nil), isOk: true, value: 42},
+		{name: "from failed pair", result: FromPair(42, errTest), isOk: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.isOk, tt.result.IsOk())
+			require.Equal(t, !tt.isOk, tt.result.IsErr())
+			require.Equal(t, tt.isOk, tt.result.ToOption().HasValue())
+			v, err := tt.result.Get()
+			if tt.isOk {
+				require.NoError(t, err)
+				require.NoError(t, tt.result.Err())
+				require.Equal(t, tt.value, v)
+				require.Equal(t, tt.value, tt.result.Unwrap())
+				require.Equal(t, tt.value, tt.result.UnwrapOr(-1))
+				require.Equal(t, tt.value, tt.result.ToOption().Value())
+				return
+			}
+			require.ErrorIs(t, err, errTest)
+			require.True(t, tt.result.Is(errTest))
+			require.Equal(t, -1, tt.result.UnwrapOr(-1))
+			require.Panics(t, func() { tt.result.Unwrap() })
+		})
+	}
+	require.Panics(t, func() { Err[int](nil) })
+}
+
+func TestResultErrors(t *testing.T) {
+	r := Err[int](fmt.Errorf("wrapped: %w", testError{code: 42}))
+	var target testError
+	require.True(t, r.As(&target))
+	require.Equal(t, 42, target.code)
+	require.False(t, r.Is(errTest))
+	require.False(t, Ok(1).As(&target))
+
+	wrapped := r.MapErr(func(err error) error { return fmt.Errorf("context: %w", err) })
+	require.True(t, wrapped.As(&target))
+	require.Equal(t, "context: wrapped: error 42", wrapped.Err().Error())
+	require.True(t, Ok(1).MapErr(func(error) error { return errTest }).IsOk())
+	require.Panics(t, func() { r.MapErr(func(error) error { return nil }) })
+}
+
+func TestResultMap(t *testing.T) {
+	double := func(n int) int { return 2 * n }
+	half := func(n int) Result[int] {
+		if n%2 != 0 {
+			return Err[int](errTest)
+		}
+		return Ok(n / 2)
+	}
+	require.Equal(t, 84, ResultMap(Ok(42), double).Unwrap())
+	require.True(t, ResultMap(Err[int](errTest), double).Is(errTest))
+	require.Equal(t, 21, ResultFlatMap(Ok(42), half).Unwrap())
+	require.True(t, ResultFlatMap(Ok(21), half).Is(errTest))
+	require.True(t, ResultFlatMap(Err[int](errors.New("other")), half).IsErr())
+	require.False(t, ResultFlatMap(Err[int](errors.New("other")), half).Is(errTest))
+}
+
+func TestCollectResults(t *testing.T) {
+	r, err := CollectResults([]Result[int]{Ok(1), Ok(2), Ok(3)})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, r)
+	r, err = CollectResults([]Result[int]{})
+	require.NoError(t, err)
+	require.Empty(t, r)
+	first := errors.New("first")
+	_, err = CollectResults([]Result[int]{Ok(1), Err[int](first), Err[int](errTest)})
+	require.ErrorIs(t, err, first)
+}
@@ -0,0 +1,191 @@
+package utils
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// insertionSort sorts small slices in place
+func insertionSort[T constraints.Ordered](s []T) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && s[j] < s[j-1]; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// medianOfThree returns the median of the first, middle and last elements of s
+func medianOfThree[T constraints.Ordered](s []T) T {
+	a, b, c := s[0], s[len(s)/2], s[len(s)-1]
+	if b < a {
+		a, b = b, a
+	}
+	if c < b {
+		b = c
+		if b < a {
+			b = a
+		}
+	}
+	return b
+}
+
+// medianOfMedians returns a pivot guaranteed to have at least 30% of the elements of s on each side
+func medianOfMedians[T constraints.Ordered](s []T) T {
+	if len(s) <= 5 {
+		c := append([]T{}, s...)
+		insertionSort(c)
+		return c[(len(c)-1)/2]
+	}
+	medians := make([]T, 0, (len(s)+4)/5)
+	for i := 0; i < len(s); i += 5 {
+		end := i + 5
+		if end > len(s) {
+			end = len(s)
+		}
+		insertionSort(s[i:end])
+		medians = append(medians, s[i+(end-i-1)/2])
+	}
+	NthElement(medians, (len(medians)-1)/2)
+	return medians[(len(medians)-1)/2]
+}
+
+// NthElement reorders s such that s[k] is the element that would be at position k if s was sorted,
+// all elements before k are not greater than s[k] and all elements after are not smaller
+// Runs quickselect on top of Partition with median of three pivots, and switches to median of medians
+// pivots as soon as a round fails to shrink s to 3/4 of its size, so the worst case is linear too
+// panic if k is out of range
+func NthElement[T constraints.Ordered](s []T, k int) {
+	if k < 0 || k >= len(s) {
+		panic("selecting an element out of range")
+	}
+	fallback := false
+	for len(s) > 16 {
+		var pivot T
+		if fallback {
+			pivot = medianOfMedians(s)
+		} else {
+			pivot = medianOfThree(s)
+		}
+		size := len(s)
+		// s[:lt] < pivot, s[lt:le] == pivot, s[le:] > pivot
+		// the middle band is built with !(pivot < e) so a NaN pivot takes all the remaining elements
+		lt := Partition(pivot, s)
+		le := lt + PartitionFilter(func(e T) bool { return !(pivot < e) }, s[lt:])
+		switch {
+		case k < lt:
+			s = s[:lt]
+		case k >= le:
+			s, k = s[le:], k-le
+		default:
+			return
+		}
+		fallback = fallback || 4*len(s) > 3*size
+	}
+	insertionSort(s)
+}
+
+// PartialSort reorders s such that s[:k] contains the k smallest elements of s in sorted order
+// the order of the remaining elements is unspecified
+// panic if k is negative, sorts s entirely if k >= len(s)
+func PartialSort[T constraints.Ordered](s []T, k int) {
+	if k < 0 {
+		panic("partial sort of a negative number of elements")
+	}
+	if k < len(s) {
+		NthElement(s, k)
+	} else {
+		k = len(s)
+	}
+	sort.Slice(s[:k], func(i, j int) bool { return s[i] < s[j] })
+}
+
+// siftDown restores the heap property of h below position i, h is a heap w.r.t. less (less(h[0], h[i]) for
+// a min-heap)
+func siftDown[T any](h []T, i int, less func(T, T) bool) {
+	for {
+		child := 2*i + 1
+		if child >= len(h) {
+			return
+		}
+		if child+1 < len(h) && less(h[child+1], h[child]) {
+			child++
+		}
+		if !less(h[child], h[i]) {
+			return
+		}
+		h[i], h[child] = h[child], h[i]
+		i = child
+	}
+}
+
+// siftUp restores the heap property of h above position i
+func siftUp[T any](h []T, i int, less func(T, T) bool) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !less(h[i], h[parent]) {
+			return
+		}
+		h[i], h[parent] = h[parent], h[i]
+		i = parent
+	}
+}
+
+// TopK returns the k greatest elements of s w.r.t. less, from the greatest to the smallest
+// s is not modified, runs in O(n log k) using a bounded heap
+// panic if k is negative
+func TopK[T any](s []T, k int, less func(T, T) bool) []T {
+	if k < 0 {
+		panic("selecting a negative number of elements")
+	}
+	if k > len(s) {
+		k = len(s)
+	}
+	// h is a min-heap of the k greatest elements seen so far
+	h := make([]T, 0, k)
+	for _, e := range s {
+		if len(h) < k {
+			h = append(h, e)
+			siftUp(h, len(h)-1, less)
+			continue
+		}
+		if k > 0 && less(h[0], e) {
+			h[0] = e
+			siftDown(h, 0, less)
+		}
+	}
+	// pop the minimum to the end to get a decreasing order
+	for end := len(h) - 1; end > 0; end-- {
+		h[0], h[end] = h[end], h[0]
+		siftDown(h[:end], 0, less)
+	}
+	return h
+}
+
+// Quantile returns the element of s at rank q (nearest lower rank), q in [0, 1]: Quantile(s, 0) is the
+// minimum, Quantile(s, 1) the maximum
+// Quantile reorders s (see NthElement) and returns an empty optional if s is empty
+// panic if q is not in [0, 1]
+func Quantile[T constraints.Ordered](s []T, q float64) *OptionImplem[T] {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		panic("quantile out of [0, 1]")
+	}
+	if len(s) == 0 {
+		return NilOption[T]()
+	}
+	k := int(math.Floor(q * float64(len(s)-1)))
+	NthElement(s, k)
+	return NewOption(s[k])
+}
+
+// Median returns the lower median of s
+// Median reorders s (see NthElement) and returns an empty optional if s is empty
+func Median[T constraints.Ordered](s []T) *OptionImplem[T] {
+	if len(s) == 0 {
+		return NilOption[T]()
+	}
+	k := (len(s) - 1) / 2
+	NthElement(s, k)
+	return NewOption(s[k])
+}
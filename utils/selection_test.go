@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// selectionInputs returns slices exercising the different paths of the selection algorithms
+func selectionInputs(rng *rand.Rand) map[string][]int {
+	sorted := buildSlice(1000, 1)
+	reversed := buildSlice(1000, 1)
+	Reverse(reversed)
+	organPipe := append(buildSlice(500, 1), reversed[500:]...)
+	// the sampled first, middle and last elements are the 3 smallest ones, so the first median of three
+	// round only removes 2 elements when selecting the maximum and NthElement falls back to median of medians
+	killer := buildSlice(1000, 1)
+	killer[1], killer[500] = killer[500], killer[1]
+	killer[2], killer[999] = killer[999], killer[2]
+	constant := make([]int, 1000)
+	random := make([]int, 1000)
+	dups := make([]int, 1000)
+	for i := range random {
+		random[i] = rng.Intn(1000000)
+		dups[i] = rng.Intn(5)
+	}
+	return map[string][]int{
+		"empty":      {},
+		"single":     {42},
+		"small":      {3, 1, 2},
+		"sorted":     sorted,
+		"reversed":   reversed,
+		"organ pipe": organPipe,
+		"killer":     killer,
+		"constant":   constant,
+		"random":     random,
+		"duplicates": dups,
+	}
+}
+
+func TestNthElement(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for name, in := range selectionInputs(rng) {
+		t.Run(name, func(t *testing.T) {
+			ref := append([]int{}, in...)
+			sort.Ints(ref)
+			for _, k := range []int{0, len(in) / 4, len(in) / 2, len(in) - 1} {
+				if k < 0 || k >= len(in) {
+					continue
+				}
+				s := append([]int{}, in...)
+				NthElement(s, k)
+				require.Equal(t, ref[k], s[k])
+				for _, e := range s[:k] {
+					require.LessOrEqual(t, e, s[k])
+				}
+				for _, e := range s[k+1:] {
+					require.GreaterOrEqual(t, e, s[k])
+				}
+				sort.Ints(s)
+				require.Equal(t, ref, s)
+			}
+			require.Panics(t, func() { NthElement(append([]int{}, in...), len(in)) })
+			require.Panics(t, func() { NthElement(append([]int{}, in...), -1) })
+		})
+	}
+}
+
+func TestNthElementNaN(t *testing.T) {
+	nan := make([]float64, 40)
+	for i := range nan {
+		nan[i] = math.NaN()
+	}
+	require.True(t, math.IsNaN(Median(nan).Value()))
+
+	// with a few NaN the order is unspecified, but the selection terminates
+	s := make([]float64, 40)
+	for i := range s {
+		s[i] = float64(len(s) - i)
+	}
+	s[7], s[20] = math.NaN(), math.NaN()
+	NthElement(s, 20)
+	require.Len(t, s, 40)
+}
+
+func TestMedianOfMedians(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 50; i++ {
+		s := shuffleSlice(rng.Intn(500)+1, 1)
+		p := medianOfMedians(s)
+		lt := len(Filter(func(e int) bool { return e < p }, s))
+		require.GreaterOrEqual(t, lt, len(s)*3/10-3)
+		require.LessOrEqual(t, lt, len(s)*7/10+3)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for name, in := range selectionInputs(rng) {
+		t.Run(name, func(t *testing.T) {
+			ref := append([]int{}, in...)
+			sort.Ints(ref)
+			for _, k := range []int{0, 1, len(in) / 3, len(in), len(in) + 5} {
+				s := append([]int{}, in...)
+				PartialSort(s, k)
+				if k > len(in) {
+					k = len(in)
+				}
+				require.Equal(t, ref[:k], s[:k])
+			}
+		})
+	}
+	require.Panics(t, func() { PartialSort([]int{1}, -1) })
+}
+
+func TestTopK(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	less := func(a, b int) bool { return a < b }
+	for name, in := range selectionInputs(rng) {
+		t.Run(name, func(t *testing.T) {
+			orig := append([]int{}, in...)
+			ref := append([]int{}, in...)
+			sort.Sort(sort.Reverse(sort.IntSlice(ref)))
+			for _, k := range []int{0, 1, 10, len(in), len(in) + 1} {
+				r := TopK(in, k, less)
+				if k > len(in) {
+					k = len(in)
+				}
+				require.Equal(t, ref[:k], r)
+				require.Equal(t, orig, in)
+			}
+		})
+	}
+	require.Panics(t, func() { TopK([]int{1}, -1, less) })
+}
+
+func TestQuantile(t *testing.T) {
+	type testCase struct {
+		name     string
+		in       []int
+		q        float64
+		expected int
+	}
+	cases := []testCase{
+		{name: "minimum", in: shuffleSlice(101, 1), q: 0, expected: 0},
+		{name: "maximum", in: shuffleSlice(101, 1), q: 1, expected: 100},
+		{name: "p50", in: shuffleSlice(101, 1), q: 0.5, expected: 50},
+		{name: "p99", in: shuffleSlice(101, 1), q: 0.99, expected: 99},
+		{name: "p90 of 10 elements", in: shuffleSlice(10, 1), q: 0.9, expected: 8},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Quantile(tt.in, tt.q)
+			require.True(t, r.HasValue())
+			require.Equal(t, tt.expected, r.Value())
+		})
+	}
+	require.False(t, Quantile([]int{}, 0.5).HasValue())
+	require.Panics(t, func() { Quantile([]int{1}, 1.5) })
+	require.Panics(t, func() { Quantile([]int{1}, -0.1) })
+}
+
+func TestMedian(t *testing.T) {
+	require.False(t, Median([]int{}).HasValue())
+	require.Equal(t, 42, Median([]int{42}).Value())
+	require.Equal(t, 2, Median([]int{3, 1, 2}).Value())
+	require.Equal(t, 2, Median([]int{4, 1, 3, 2}).Value())
+	require.Equal(t, 50, Median(shuffleSlice(101, 1)).Value())
+	require.Equal(t, "b", Median([]string{"c", "a", "b"}).Value())
+}
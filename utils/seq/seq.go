@@ -0,0 +1,179 @@
+// Package seq provides lazy combinators over iterators (iter.Seq)
+//
+// Unlike utils.Map or utils.Filter, combinators do not materialize intermediate slices: elements
+// are produced one by one when the resulting sequence is consumed, and consumption stops as soon
+// as the consumer does. All combinators take the input sequence as first argument.
+package seq
+
+import (
+	"iter"
+)
+
+// FromSlice returns a sequence of the elements of s
+func FromSlice[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns the sequence of f(e) for all e in s
+func Map[T1, T2 any](s iter.Seq[T1], f func(T1) T2) iter.Seq[T2] {
+	return func(yield func(T2) bool) {
+		for e := range s {
+			if !yield(f(e)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns the sequence of elements of s for which f returns true
+func Filter[T any](s iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := range s {
+			if f(e) && !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap returns the concatenation of the sequences f(e) for all e in s
+func FlatMap[T1, T2 any](s iter.Seq[T1], f func(T1) iter.Seq[T2]) iter.Seq[T2] {
+	return func(yield func(T2) bool) {
+		for e := range s {
+			for x := range f(e) {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Take returns the sequence of the n first elements of s
+func Take[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for e := range s {
+			if !yield(e) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Drop returns the sequence of elements of s without the n first ones
+func Drop[T any](s iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for e := range s {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns the longest prefix of s whose elements satisfy f
+func TakeWhile[T any](s iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := range s {
+			if !f(e) || !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile returns the sequence of elements of s without the longest prefix whose elements satisfy f
+func DropWhile[T any](s iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for e := range s {
+			if dropping && f(e) {
+				continue
+			}
+			dropping = false
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Zip returns the sequence of pairs of elements of s1 and s2 at the same position
+// the sequence stops with the shortest input
+func Zip[T1, T2 any](s1 iter.Seq[T1], s2 iter.Seq[T2]) iter.Seq2[T1, T2] {
+	return func(yield func(T1, T2) bool) {
+		next, stop := iter.Pull(s2)
+		defer stop()
+		for e1 := range s1 {
+			e2, ok := next()
+			if !ok || !yield(e1, e2) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns the sequence of elements of s with their position
+func Enumerate[T any](s iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for e := range s {
+			if !yield(i, e) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Chain returns the concatenation of all sequences in seqs
+func Chain[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, s := range seqs {
+			for e := range s {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reduce traverses s and accumulates results of function f with initial value init
+// Reduce({a1, ..., an}, init, f) -> f( ... f(f(init, a1), a2) ..., an)
+// Same as utils.Accumulate, with the sequence first like all combinators of this package
+func Reduce[T1, T2 any](s iter.Seq[T1], init T2, f func(T2, T1) T2) T2 {
+	r := init
+	for e := range s {
+		r = f(r, e)
+	}
+	return r
+}
+
+// Collect returns the slice of all elements of s
+func Collect[T any](s iter.Seq[T]) []T {
+	var r []T
+	for e := range s {
+		r = append(r, e)
+	}
+	return r
+}
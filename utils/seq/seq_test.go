@@ -0,0 +1,156 @@
+package seq
+
+import (
+	"iter"
+	"strconv"
+	"testing"
+
+	"github.com/slashvar/go-toolbox/deque"
+	"github.com/stretchr/testify/require"
+)
+
+// count returns the infinite sequence 0, 1, 2, ... and a pointer to the number of produced elements
+func count() (iter.Seq[int], *int) {
+	produced := 0
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}, &produced
+}
+
+func buildSlice(n int) []int {
+	r := make([]int, n)
+	for i := range r {
+		r[i] = i
+	}
+	return r
+}
+
+func TestMapFilter(t *testing.T) {
+	type testCases struct {
+		name     string
+		in       []int
+		expected []string
+	}
+	cases := []testCases{
+		{name: "empty sequence", in: []int{}, expected: nil},
+		{name: "some elements", in: buildSlice(10), expected: []string{"0", "4", "8", "12", "16"}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			even := func(n int) bool { return n%2 == 0 }
+			double := func(n int) string { return strconv.Itoa(2 * n) }
+			r := Collect(Map(Filter(FromSlice(tt.in), even), double))
+			require.Equal(t, tt.expected, r)
+		})
+	}
+}
+
+func TestLaziness(t *testing.T) {
+	s, produced := count()
+	calls := 0
+	square := func(n int) int {
+		calls++
+		return n * n
+	}
+	r := Map(Filter(s, func(n int) bool { return n%2 == 1 }), square)
+	require.Equal(t, 0, *produced)
+	require.Equal(t, []int{1, 9, 25}, Collect(Take(r, 3)))
+	require.Equal(t, 6, *produced)
+	require.Equal(t, 3, calls)
+}
+
+func TestTakeDrop(t *testing.T) {
+	type testCases struct {
+		name string
+		in   []int
+		n    int
+		take []int
+		drop []int
+	}
+	cases := []testCases{
+		{name: "empty sequence", in: []int{}, n: 2, take: nil, drop: nil},
+		{name: "zero", in: buildSlice(5), n: 0, take: nil, drop: buildSlice(5)},
+		{name: "negative", in: buildSlice(5), n: -1, take: nil, drop: buildSlice(5)},
+		{name: "middle", in: buildSlice(5), n: 2, take: []int{0, 1}, drop: []int{2, 3, 4}},
+		{name: "too many", in: buildSlice(5), n: 10, take: buildSlice(5), drop: nil},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.take, Collect(Take(FromSlice(tt.in), tt.n)))
+			require.Equal(t, tt.drop, Collect(Drop(FromSlice(tt.in), tt.n)))
+		})
+	}
+	s, produced := count()
+	require.Equal(t, []int{2, 3}, Collect(Take(Drop(s, 2), 2)))
+	require.Equal(t, 4, *produced)
+}
+
+func TestTakeWhile(t *testing.T) {
+	small := func(n int) bool { return n < 3 }
+	s, produced := count()
+	require.Equal(t, []int{0, 1, 2}, Collect(TakeWhile(s, small)))
+	require.Equal(t, 4, *produced)
+	require.Equal(t, []int{3, 4, 0}, Collect(DropWhile(FromSlice([]int{0, 1, 2, 3, 4, 0}), small)))
+	require.Nil(t, Collect(TakeWhile(FromSlice([]int{}), small)))
+	require.Nil(t, Collect(DropWhile(FromSlice([]int{0, 1}), small)))
+}
+
+func TestFlatMap(t *testing.T) {
+	repeat := func(n int) iter.Seq[int] {
+		return Take(func(yield func(int) bool) {
+			for yield(n) {
+			}
+		}, n)
+	}
+	require.Equal(t, []int{1, 2, 2, 3, 3, 3}, Collect(FlatMap(FromSlice([]int{0, 1, 2, 3}), repeat)))
+	s, _ := count()
+	require.Equal(t, []int{1, 2, 2, 3}, Collect(Take(FlatMap(s, repeat), 4)))
+}
+
+func TestZipEnumerate(t *testing.T) {
+	var keys []int
+	var values []string
+	for k, v := range Zip(FromSlice([]int{1, 2, 3}), FromSlice([]string{"a", "b"})) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	require.Equal(t, []int{1, 2}, keys)
+	require.Equal(t, []string{"a", "b"}, values)
+
+	s, produced := count()
+	for k, v := range Zip(s, FromSlice([]int{10, 11})) {
+		require.Equal(t, k+10, v)
+	}
+	require.LessOrEqual(t, *produced, 3)
+
+	for i, v := range Enumerate(FromSlice([]string{"0", "1", "2"})) {
+		require.Equal(t, strconv.Itoa(i), v)
+		if i == 1 {
+			break
+		}
+	}
+}
+
+func TestChainReduce(t *testing.T) {
+	c := Chain(FromSlice([]int{1, 2}), FromSlice([]int{}), FromSlice([]int{3}))
+	require.Equal(t, []int{1, 2, 3}, Collect(c))
+	require.Equal(t, []int{1, 2}, Collect(Take(c, 2)))
+	require.Nil(t, Collect(Chain[int]()))
+	sum := func(a, b int) int { return a + b }
+	require.Equal(t, 45, Reduce(FromSlice(buildSlice(10)), 0, sum))
+	require.Equal(t, 42, Reduce(FromSlice([]int{}), 42, sum))
+}
+
+func TestDeque(t *testing.T) {
+	q := deque.New[int]()
+	for i := 0; i < 10; i++ {
+		q.PushFront(i)
+	}
+	r := Collect(Take(Filter(q.Values(), func(n int) bool { return n%3 == 0 }), 3))
+	require.Equal(t, []int{9, 6, 3}, r)
+}
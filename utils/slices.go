@@ -90,24 +90,69 @@ func Insert[T any](x T, pos int, s []T) []T {
 	return s
 }
 
-// LowerBound returns the position of the first elements that is not smaller than x
-// LowerBound returns a coherent result only if s is sorted
-func LowerBound[T constraints.Ordered](x T, s []T) int {
+// PartitionPoint returns the position of the first element of s for which f returns false
+// s must be partitioned w.r.t. f: f returns true for a prefix of s and false for the rest
+func PartitionPoint[T any](s []T, f func(T) bool) int {
 	left, right := 0, len(s)
 	for left < right {
 		mid := left + (right-left)/2
-		if s[mid] == x {
-			return mid
-		}
-		if x < s[mid] {
-			right = mid
+		if f(s[mid]) {
+			left = mid + 1
 			continue
 		}
-		left = mid + 1
+		right = mid
 	}
 	return left
 }
 
+// LowerBoundFunc returns the position of the first element e of s such that cmp(e, x) >= 0
+// LowerBoundFunc returns a coherent result only if s is sorted w.r.t. cmp
+func LowerBoundFunc[T any](x T, s []T, cmp func(T, T) int) int {
+	return PartitionPoint(s, func(e T) bool { return cmp(e, x) < 0 })
+}
+
+// LowerBound returns the position of the first element that is not smaller than x
+// LowerBound returns a coherent result only if s is sorted
+func LowerBound[T constraints.Ordered](x T, s []T) int {
+	return PartitionPoint(s, func(e T) bool { return e < x })
+}
+
+// UpperBoundFunc returns the position of the first element e of s such that cmp(e, x) > 0
+// UpperBoundFunc returns a coherent result only if s is sorted w.r.t. cmp
+func UpperBoundFunc[T any](x T, s []T, cmp func(T, T) int) int {
+	return PartitionPoint(s, func(e T) bool { return cmp(e, x) <= 0 })
+}
+
+// UpperBound returns the position of the first element that is greater than x
+// UpperBound returns a coherent result only if s is sorted
+func UpperBound[T constraints.Ordered](x T, s []T) int {
+	return PartitionPoint(s, func(e T) bool { return e <= x })
+}
+
+// EqualRangeFunc returns the bounds of the range of elements equivalent to x w.r.t. cmp, s[lo:hi]
+// EqualRangeFunc returns a coherent result only if s is sorted w.r.t. cmp
+func EqualRangeFunc[T any](x T, s []T, cmp func(T, T) int) (int, int) {
+	lo := LowerBoundFunc(x, s, cmp)
+	return lo, lo + UpperBoundFunc(x, s[lo:], cmp)
+}
+
+// EqualRange returns the bounds of the range of elements equal to x, s[lo:hi]
+// EqualRange returns a coherent result only if s is sorted
+func EqualRange[T constraints.Ordered](x T, s []T) (int, int) {
+	lo := LowerBound(x, s)
+	return lo, lo + UpperBound(x, s[lo:])
+}
+
+// InsertSortedFunc inserts x in s before the first element not smaller than x w.r.t. cmp, keeping s sorted
+func InsertSortedFunc[T any](x T, s []T, cmp func(T, T) int) []T {
+	return Insert(x, LowerBoundFunc(x, s, cmp), s)
+}
+
+// InsertSorted inserts x in s before the first element not smaller than x, keeping s sorted
+func InsertSorted[T constraints.Ordered](x T, s []T) []T {
+	return Insert(x, LowerBound(x, s), s)
+}
+
 // Partition moves elements to form a partition and returns the position of the pivot
 // Let p := Partition(x, s) then for all i < p s[i] < x and for all i >= p, s[i] >= x
 func Partition[T constraints.Ordered](x T, s []T) int {
@@ -134,6 +179,84 @@ func PartitionFilter[T any](f func(T) bool, s []T) int {
 	return pivot
 }
 
+// Partition3 moves elements to form a three-way partition around x (Dutch national flag)
+// Let lt, gt := Partition3(x, s) then s[i] < x for i < lt, s[i] == x for lt <= i < gt and s[i] > x for i >= gt
+func Partition3[T constraints.Ordered](x T, s []T) (int, int) {
+	lt, i, gt := 0, 0, len(s)
+	for i < gt {
+		switch {
+		case s[i] < x:
+			s[i], s[lt] = s[lt], s[i]
+			lt++
+			i++
+		case x < s[i]:
+			gt--
+			s[i], s[gt] = s[gt], s[i]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// IsPartitioned returns true if all elements of s for which f returns true come before the others
+func IsPartitioned[T any](f func(T) bool, s []T) bool {
+	i := 0
+	for ; i < len(s) && f(s[i]); i++ {
+		continue
+	}
+	for ; i < len(s); i++ {
+		if f(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// StablePartition moves elements to form a partition w.r.t. to f, keeping the relative order of elements
+// in both parts, and returns the position of the first element for which f is false
+// Uses a buffer of the size of the second part, see StablePartitionInPlace to avoid allocation
+func StablePartition[T any](f func(T) bool, s []T) int {
+	var rejected []T
+	pivot := 0
+	for _, e := range s {
+		if f(e) {
+			s[pivot] = e
+			pivot++
+			continue
+		}
+		rejected = append(rejected, e)
+	}
+	copy(s[pivot:], rejected)
+	return pivot
+}
+
+// StablePartitionInPlace is StablePartition without extra allocation, it runs in O(n log n)
+func StablePartitionInPlace[T any](f func(T) bool, s []T) int {
+	switch len(s) {
+	case 0:
+		return 0
+	case 1:
+		if f(s[0]) {
+			return 1
+		}
+		return 0
+	}
+	mid := len(s) / 2
+	left := StablePartitionInPlace(f, s[:mid])
+	right := mid + StablePartitionInPlace(f, s[mid:])
+	// s is now T1 F1 T2 F2, rotate F1 T2 to get T1 T2 F1 F2
+	rotate(s[left:right], mid-left)
+	return left + right - mid
+}
+
+// rotate rotates s to the left by k positions
+func rotate[T any](s []T, k int) {
+	Reverse(s[:k])
+	Reverse(s[k:])
+	Reverse(s)
+}
+
 // Combine combines elements of s1 and s2 using zipper function
 // returned slice of the length of the smallest input
 func Combine[T1, T2, T3 any](s1 []T1, s2 []T2, zipper func(T1, T2) T3) []T3 {
@@ -143,3 +266,187 @@ func Combine[T1, T2, T3 any](s1 []T1, s2 []T2, zipper func(T1, T2) T3) []T3 {
 	}
 	return r
 }
+
+// FindIndex returns the position of the first element of s for which f returns true
+// returns an empty optional if there is no such element
+func FindIndex[T any](f func(T) bool, s []T) *OptionImplem[int] {
+	for i, e := range s {
+		if f(e) {
+			return NewOption(i)
+		}
+	}
+	return NilOption[int]()
+}
+
+// FindLastIndex returns the position of the last element of s for which f returns true
+// returns an empty optional if there is no such element
+func FindLastIndex[T any](f func(T) bool, s []T) *OptionImplem[int] {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(s[i]) {
+			return NewOption(i)
+		}
+	}
+	return NilOption[int]()
+}
+
+// Find returns the first element of s for which f returns true
+// returns an empty optional if there is no such element
+func Find[T any](f func(T) bool, s []T) *OptionImplem[T] {
+	if i, ok := FindIndex(f, s).Get(); ok {
+		return NewOption(s[i])
+	}
+	return NilOption[T]()
+}
+
+// FindLast returns the last element of s for which f returns true
+// returns an empty optional if there is no such element
+func FindLast[T any](f func(T) bool, s []T) *OptionImplem[T] {
+	if i, ok := FindLastIndex(f, s).Get(); ok {
+		return NewOption(s[i])
+	}
+	return NilOption[T]()
+}
+
+// BinarySearch returns the position of the first element equal to x in s
+// returns an empty optional if x is not in s
+// BinarySearch returns a coherent result only if s is sorted
+func BinarySearch[T constraints.Ordered](x T, s []T) *OptionImplem[int] {
+	p := LowerBound(x, s)
+	if p < len(s) && s[p] == x {
+		return NewOption(p)
+	}
+	return NilOption[int]()
+}
+
+// Min returns the smallest element of s, returns an empty optional if s is empty
+func Min[T constraints.Ordered](s []T) *OptionImplem[T] {
+	if len(s) == 0 {
+		return NilOption[T]()
+	}
+	r := s[0]
+	for _, e := range s[1:] {
+		if e < r {
+			r = e
+		}
+	}
+	return NewOption(r)
+}
+
+// Max returns the greatest element of s, returns an empty optional if s is empty
+func Max[T constraints.Ordered](s []T) *OptionImplem[T] {
+	if len(s) == 0 {
+		return NilOption[T]()
+	}
+	r := s[0]
+	for _, e := range s[1:] {
+		if r < e {
+			r = e
+		}
+	}
+	return NewOption(r)
+}
+
+// Head returns the first element of s, returns an empty optional if s is empty
+// Alias of FirstOf, which is the canonical name, kept next to the other search functions
+func Head[T any](s []T) *OptionImplem[T] {
+	return FirstOf(s)
+}
+
+// Last returns the last element of s, returns an empty optional if s is empty
+// Alias of LastOf, which is the canonical name, kept next to the other search functions
+func Last[T any](s []T) *OptionImplem[T] {
+	return LastOf(s)
+}
+
+// Chunk splits s in consecutive subslices of length n, the last one may be shorter
+// subslices share the storage of s, their capacity is limited so appending to them does not overwrite s
+// panic if n is less or equal to 0
+func Chunk[T any](s []T, n int) [][]T {
+	if n <= 0 {
+		panic("chunking with a non-positive size")
+	}
+	r := make([][]T, 0, (len(s)+n-1)/n)
+	for i := 0; i < len(s); i += n {
+		end := i + n
+		if end > len(s) {
+			end = len(s)
+		}
+		r = append(r, s[i:end:end])
+	}
+	return r
+}
+
+// Windows returns all the overlapping subslices of s of length n, in order
+// returns no window if s is shorter than n
+// subslices share the storage of s, their capacity is limited so appending to them does not overwrite s
+// panic if n is less or equal to 0
+func Windows[T any](s []T, n int) [][]T {
+	if n <= 0 {
+		panic("windowing with a non-positive size")
+	}
+	if len(s) < n {
+		return [][]T{}
+	}
+	r := make([][]T, 0, len(s)-n+1)
+	for i := 0; i+n <= len(s); i++ {
+		r = append(r, s[i:i+n:i+n])
+	}
+	return r
+}
+
+// GroupBy groups elements of s by key, keeping their relative order in each group
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	acc := func(r map[K][]T, e T) map[K][]T {
+		k := key(e)
+		r[k] = append(r[k], e)
+		return r
+	}
+	return Accumulate(acc, map[K][]T{}, s)
+}
+
+// GroupConsecutive splits s in maximal runs of consecutive elements such that eq returns true on all pairs of
+// neighbours in a run
+// subslices share the storage of s, their capacity is limited so appending to them does not overwrite s
+func GroupConsecutive[T any](s []T, eq func(T, T) bool) [][]T {
+	r := [][]T{}
+	start := 0
+	for i := 1; i <= len(s); i++ {
+		if i == len(s) || !eq(s[i-1], s[i]) {
+			r = append(r, s[start:i:i])
+			start = i
+		}
+	}
+	return r
+}
+
+// CountBy returns the number of elements of s for each key
+func CountBy[T any, K comparable](s []T, key func(T) K) map[K]int {
+	acc := func(r map[K]int, e T) map[K]int {
+		r[key(e)]++
+		return r
+	}
+	return Accumulate(acc, map[K]int{}, s)
+}
+
+// IndexBy maps each element of s by its key, for duplicated keys the last element wins
+func IndexBy[T any, K comparable](s []T, key func(T) K) map[K]T {
+	acc := func(r map[K]T, e T) map[K]T {
+		r[key(e)] = e
+		return r
+	}
+	return Accumulate(acc, map[K]T{}, s)
+}
+
+// PartitionBy returns the elements of s for which f returns true and the others, keeping their relative order
+// Unlike PartitionFilter, s is not modified
+func PartitionBy[T any](s []T, f func(T) bool) ([]T, []T) {
+	yes, no := []T{}, []T{}
+	for _, e := range s {
+		if f(e) {
+			yes = append(yes, e)
+			continue
+		}
+		no = append(no, e)
+	}
+	return yes, no
+}
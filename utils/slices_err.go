@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IndexError wraps the error returned by a callback with the position of the element being processed
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+// Error implements error interface
+func (e IndexError) Error() string {
+	return fmt.Sprintf("at index %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the wrapped error, see errors.Unwrap
+func (e IndexError) Unwrap() error {
+	return e.Err
+}
+
+// MapErr converts a slice of InType into a slice of OutType using function f
+// stops at the first error and returns it wrapped in an IndexError
+func MapErr[InType, OutType any](in []InType, f func(InType) (OutType, error)) ([]OutType, error) {
+	r := make([]OutType, 0, len(in))
+	for i, e := range in {
+		x, err := f(e)
+		if err != nil {
+			return nil, IndexError{Index: i, Err: err}
+		}
+		r = append(r, x)
+	}
+	return r, nil
+}
+
+// MapErrAll converts a slice of InType into a slice of OutType using function f
+// all elements are processed, errors are wrapped in IndexError and joined, see errors.Join
+// the returned slice contains the zero value of OutType for failing elements
+func MapErrAll[InType, OutType any](in []InType, f func(InType) (OutType, error)) ([]OutType, error) {
+	r := make([]OutType, len(in))
+	var errs []error
+	for i, e := range in {
+		x, err := f(e)
+		if err != nil {
+			errs = append(errs, IndexError{Index: i, Err: err})
+			continue
+		}
+		r[i] = x
+	}
+	return r, errors.Join(errs...)
+}
+
+// IterErr applies f to all elements of s
+// stops at the first error and returns it wrapped in an IndexError
+func IterErr[T any](s []T, f func(T) error) error {
+	for i, e := range s {
+		if err := f(e); err != nil {
+			return IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// AccumulateErr traverses s and accumulates results of function f with initial value init
+// stops at the first error and returns it wrapped in an IndexError with the accumulated value so far
+func AccumulateErr[InType, OutType any](f func(OutType, InType) (OutType, error), init OutType, s []InType) (OutType, error) {
+	r := init
+	for i, e := range s {
+		x, err := f(r, e)
+		if err != nil {
+			return r, IndexError{Index: i, Err: err}
+		}
+		r = x
+	}
+	return r, nil
+}
+
+// FilterErr returns all the elements of s for which f returns true
+// stops at the first error and returns it wrapped in an IndexError
+func FilterErr[T any](f func(T) (bool, error), s []T) ([]T, error) {
+	acc := func(r []T, e T) ([]T, error) {
+		keep, err := f(e)
+		if keep && err == nil {
+			return append(r, e), nil
+		}
+		return r, err
+	}
+	r, err := AccumulateErr(acc, []T{}, s)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// CombineErr combines elements of s1 and s2 using zipper function
+// returned slice of the length of the smallest input
+// stops at the first error and returns it wrapped in an IndexError
+func CombineErr[T1, T2, T3 any](s1 []T1, s2 []T2, zipper func(T1, T2) (T3, error)) ([]T3, error) {
+	var r []T3
+	for i := 0; i < len(s1) && i < len(s2); i++ {
+		x, err := zipper(s1[i], s2[i])
+		if err != nil {
+			return nil, IndexError{Index: i, Err: err}
+		}
+		r = append(r, x)
+	}
+	return r, nil
+}
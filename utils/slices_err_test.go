@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// elementFailure is returned by failing callbacks in error-returning helpers tests
+type elementFailure struct {
+	element int
+}
+
+func (e elementFailure) Error() string {
+	return fmt.Sprintf("failure on %d", e.element)
+}
+
+// failAt returns an error for elements in indices
+func failAt(indices ...int) func(int) error {
+	return func(n int) error {
+		for _, i := range indices {
+			if n == i {
+				return elementFailure{i}
+			}
+		}
+		return nil
+	}
+}
+
+func requireIndexError(t *testing.T, err error, index int) {
+	var ie IndexError
+	require.True(t, errors.As(err, &ie))
+	require.Equal(t, index, ie.Index)
+	require.Equal(t, elementFailure{index}, ie.Err)
+	require.ErrorIs(t, err, elementFailure{index})
+}
+
+func TestMapErr(t *testing.T) {
+	type testCases struct {
+		name   string
+		in     []int
+		fail   []int
+		failed int
+	}
+	cases := []testCases{
+		{name: "empty slice", in: []int{}, failed: -1},
+		{name: "no failure", in: buildSlice(10, 1), failed: -1},
+		{name: "stop at first failure", in: buildSlice(10, 1), fail: []int{7, 3}, failed: 3},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			check := failAt(tt.fail...)
+			double := func(n int) (int, error) {
+				calls++
+				return 2 * n, check(n)
+			}
+			r, err := MapErr(tt.in, double)
+			if tt.failed >= 0 {
+				requireIndexError(t, err, tt.failed)
+				require.Nil(t, r)
+				require.Equal(t, tt.failed+1, calls)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, Map(tt.in, func(n int) int { return 2 * n }), r)
+		})
+	}
+}
+
+func TestMapErrAll(t *testing.T) {
+	parse := func(s string) (int, error) { return strconv.Atoi(s) }
+	r, err := MapErrAll([]string{"1", "a", "3", "b"}, parse)
+	require.Equal(t, []int{1, 0, 3, 0}, r)
+	var numErr *strconv.NumError
+	require.ErrorAs(t, err, &numErr)
+	require.Equal(t, "at index 1: strconv.Atoi: parsing \"a\": invalid syntax\nat index 3: strconv.Atoi: parsing \"b\": invalid syntax", err.Error())
+	r, err = MapErrAll([]string{"1", "2"}, parse)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, r)
+}
+
+func TestIterErr(t *testing.T) {
+	calls := 0
+	check := failAt(4, 8)
+	err := IterErr(buildSlice(10, 1), func(n int) error {
+		calls++
+		return check(n)
+	})
+	requireIndexError(t, err, 4)
+	require.Equal(t, 5, calls)
+	require.NoError(t, IterErr(buildSlice(10, 1), failAt()))
+}
+
+func TestAccumulateErr(t *testing.T) {
+	check := failAt(5)
+	sum := func(a, n int) (int, error) {
+		if err := check(n); err != nil {
+			return 0, err
+		}
+		return a + n, nil
+	}
+	r, err := AccumulateErr(sum, 0, buildSlice(5, 1))
+	require.NoError(t, err)
+	require.Equal(t, 10, r)
+	r, err = AccumulateErr(sum, 0, buildSlice(10, 1))
+	requireIndexError(t, err, 5)
+	require.Equal(t, 10, r)
+}
+
+func TestFilterErr(t *testing.T) {
+	check := failAt(6)
+	even := func(n int) (bool, error) { return n%2 == 0, check(n) }
+	r, err := FilterErr(even, buildSlice(6, 1))
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 2, 4}, r)
+	r, err = FilterErr(even, buildSlice(10, 1))
+	requireIndexError(t, err, 6)
+	require.Nil(t, r)
+}
+
+func TestCombineErr(t *testing.T) {
+	check := failAt(3)
+	add := func(a, b int) (int, error) { return a + b, check(a) }
+	r, err := CombineErr(buildSlice(3, 1), buildSlice(10, 1), add)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 2, 4}, r)
+	r, err = CombineErr(buildSlice(10, 1), buildSlice(5, 1), add)
+	requireIndexError(t, err, 3)
+	require.Nil(t, r)
+}
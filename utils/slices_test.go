@@ -2,6 +2,7 @@ package utils
 
 import (
 	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -524,3 +525,403 @@ func TestCombine(t *testing.T) {
 		})
 	}
 }
+
+func TestFind(t *testing.T) {
+	type testCase struct {
+		name      string
+		in        []int
+		predicate func(int) bool
+		first     int
+		last      int
+		found     bool
+	}
+	cases := []testCase{
+		{
+			name:      "empty slice",
+			in:        []int{},
+			predicate: func(int) bool { return true },
+			found:     false,
+		},
+		{
+			name:      "no match",
+			in:        buildSlice(10, 2),
+			predicate: func(n int) bool { return n%2 == 1 },
+			found:     false,
+		},
+		{
+			name:      "single match",
+			in:        buildSlice(10, 1),
+			predicate: func(n int) bool { return n == 5 },
+			first:     5,
+			last:      5,
+			found:     true,
+		},
+		{
+			name:      "several matches",
+			in:        buildSlice(10, 1),
+			predicate: func(n int) bool { return n%3 == 1 },
+			first:     1,
+			last:      7,
+			found:     true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			i := FindIndex(tt.predicate, tt.in)
+			li := FindLastIndex(tt.predicate, tt.in)
+			f := Find(tt.predicate, tt.in)
+			l := FindLast(tt.predicate, tt.in)
+			require.Equal(t, tt.found, i.HasValue())
+			require.Equal(t, tt.found, li.HasValue())
+			require.Equal(t, tt.found, f.HasValue())
+			require.Equal(t, tt.found, l.HasValue())
+			if tt.found {
+				require.Equal(t, tt.first, i.Value())
+				require.Equal(t, tt.last, li.Value())
+				require.Equal(t, tt.in[tt.first], f.Value())
+				require.Equal(t, tt.in[tt.last], l.Value())
+			}
+		})
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	in := buildSlice(10, 2)
+	for i, e := range in {
+		r := BinarySearch(e, in)
+		require.True(t, r.HasValue())
+		require.Equal(t, i, r.Value())
+		require.False(t, BinarySearch(e+1, in).HasValue())
+	}
+	require.False(t, BinarySearch(-1, in).HasValue())
+	require.False(t, BinarySearch(0, []int{}).HasValue())
+}
+
+func TestMinMax(t *testing.T) {
+	type testCase struct {
+		name  string
+		in    []int
+		min   int
+		max   int
+		found bool
+	}
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, found: false},
+		{name: "single element", in: []int{42}, min: 42, max: 42, found: true},
+		{name: "sorted slice", in: buildSlice(10, 1), min: 0, max: 9, found: true},
+		{name: "shuffled slice", in: shuffleSlice(10, 1), min: 0, max: 9, found: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			min, max := Min(tt.in), Max(tt.in)
+			require.Equal(t, tt.found, min.HasValue())
+			require.Equal(t, tt.found, max.HasValue())
+			if tt.found {
+				require.Equal(t, tt.min, min.Value())
+				require.Equal(t, tt.max, max.Value())
+			}
+		})
+	}
+}
+
+func TestHeadLast(t *testing.T) {
+	require.False(t, Head([]int{}).HasValue())
+	require.False(t, Last([]int{}).HasValue())
+	in := buildSlice(10, 1)
+	require.Equal(t, 0, Head(in).Value())
+	require.Equal(t, 9, Last(in).Value())
+}
+
+func TestChunk(t *testing.T) {
+	type testCase struct {
+		name     string
+		in       []int
+		n        int
+		expected [][]int
+	}
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, n: 3, expected: [][]int{}},
+		{name: "exact chunks", in: buildSlice(6, 1), n: 3, expected: [][]int{{0, 1, 2}, {3, 4, 5}}},
+		{name: "last chunk shorter", in: buildSlice(5, 1), n: 2, expected: [][]int{{0, 1}, {2, 3}, {4}}},
+		{name: "chunk larger than slice", in: buildSlice(2, 1), n: 5, expected: [][]int{{0, 1}}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Chunk(tt.in, tt.n)
+			require.Equal(t, tt.expected, r)
+			if len(r) > 1 {
+				_ = append(r[0], 42)
+				require.Equal(t, tt.expected[1], r[1])
+				r[1][0] = 42
+				require.Equal(t, 42, tt.in[tt.n])
+			}
+		})
+	}
+	require.Panics(t, func() { Chunk([]int{1}, 0) })
+}
+
+func TestWindows(t *testing.T) {
+	type testCase struct {
+		name     string
+		in       []int
+		n        int
+		expected [][]int
+	}
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, n: 2, expected: [][]int{}},
+		{name: "slice too short", in: buildSlice(2, 1), n: 3, expected: [][]int{}},
+		{name: "single window", in: buildSlice(3, 1), n: 3, expected: [][]int{{0, 1, 2}}},
+		{name: "overlapping windows", in: buildSlice(5, 1), n: 3, expected: [][]int{{0, 1, 2}, {1, 2, 3}, {2, 3, 4}}},
+		{name: "windows of one", in: buildSlice(3, 1), n: 1, expected: [][]int{{0}, {1}, {2}}},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := Windows(tt.in, tt.n)
+			require.Equal(t, tt.expected, r)
+			for _, w := range r {
+				require.Equal(t, tt.n, cap(w))
+			}
+		})
+	}
+	require.Panics(t, func() { Windows([]int{1}, -1) })
+}
+
+func TestGroupBy(t *testing.T) {
+	mod3 := func(n int) int { return n % 3 }
+	in := buildSlice(10, 1)
+	require.Equal(t, map[int][]int{0: {0, 3, 6, 9}, 1: {1, 4, 7}, 2: {2, 5, 8}}, GroupBy(in, mod3))
+	require.Equal(t, map[int]int{0: 4, 1: 3, 2: 3}, CountBy(in, mod3))
+	require.Equal(t, map[int]int{0: 9, 1: 7, 2: 8}, IndexBy(in, mod3))
+	require.Empty(t, GroupBy([]int{}, mod3))
+	require.Empty(t, CountBy([]int{}, mod3))
+	require.Empty(t, IndexBy([]int{}, mod3))
+}
+
+func TestGroupConsecutive(t *testing.T) {
+	type testCase struct {
+		name     string
+		in       []int
+		eq       func(int, int) bool
+		expected [][]int
+	}
+	same := func(a, b int) bool { return a == b }
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, eq: same, expected: [][]int{}},
+		{name: "single element", in: []int{1}, eq: same, expected: [][]int{{1}}},
+		{name: "runs", in: []int{1, 1, 2, 3, 3, 3, 1}, eq: same, expected: [][]int{{1, 1}, {2}, {3, 3, 3}, {1}}},
+		{
+			name:     "increasing runs",
+			in:       []int{1, 2, 3, 2, 3, 0},
+			eq:       func(a, b int) bool { return a < b },
+			expected: [][]int{{1, 2, 3}, {2, 3}, {0}},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, GroupConsecutive(tt.in, tt.eq))
+		})
+	}
+}
+
+func TestPartitionBy(t *testing.T) {
+	in := shuffleSlice(10, 1)
+	orig := append([]int{}, in...)
+	even := func(n int) bool { return n%2 == 0 }
+	yes, no := PartitionBy(in, even)
+	require.Equal(t, orig, in)
+	require.Equal(t, Filter(even, in), yes)
+	require.Equal(t, Filter(func(n int) bool { return !even(n) }, in), no)
+	yes, no = PartitionBy([]int{}, even)
+	require.Empty(t, yes)
+	require.Empty(t, no)
+}
+
+func TestBoundsWithDuplicates(t *testing.T) {
+	type testCase struct {
+		name  string
+		in    []int
+		value int
+		lower int
+		upper int
+	}
+	dup := []int{1, 1, 1, 2, 2, 2, 2, 2, 3, 5, 5, 5}
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, value: 0, lower: 0, upper: 0},
+		{name: "all equal", in: []int{2, 2, 2, 2, 2, 2, 2}, value: 2, lower: 0, upper: 7},
+		{name: "first run", in: dup, value: 1, lower: 0, upper: 3},
+		{name: "middle run", in: dup, value: 2, lower: 3, upper: 8},
+		{name: "single element", in: dup, value: 3, lower: 8, upper: 9},
+		{name: "missing element", in: dup, value: 4, lower: 9, upper: 9},
+		{name: "last run", in: dup, value: 5, lower: 9, upper: 12},
+		{name: "before range", in: dup, value: 0, lower: 0, upper: 0},
+		{name: "after range", in: dup, value: 6, lower: 12, upper: 12},
+	}
+	reversed := func(a, b int) int { return b - a }
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.lower, LowerBound(tt.value, tt.in))
+			require.Equal(t, tt.upper, UpperBound(tt.value, tt.in))
+			lo, hi := EqualRange(tt.value, tt.in)
+			require.Equal(t, tt.lower, lo)
+			require.Equal(t, tt.upper, hi)
+
+			rev := append([]int{}, tt.in...)
+			Reverse(rev)
+			n := len(rev)
+			require.Equal(t, n-tt.upper, LowerBoundFunc(tt.value, rev, reversed))
+			require.Equal(t, n-tt.lower, UpperBoundFunc(tt.value, rev, reversed))
+			lo, hi = EqualRangeFunc(tt.value, rev, reversed)
+			require.Equal(t, n-tt.upper, lo)
+			require.Equal(t, n-tt.lower, hi)
+		})
+	}
+}
+
+func TestBoundsRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		s := randomSorted(rng, rng.Intn(50), 10)
+		x := rng.Intn(12) - 1
+		require.Equal(t, sort.SearchInts(s, x), LowerBound(x, s))
+		require.Equal(t, sort.SearchInts(s, x+1), UpperBound(x, s))
+		if p := BinarySearch(x, s); p.HasValue() {
+			require.Equal(t, sort.SearchInts(s, x), p.Value())
+		}
+	}
+}
+
+func TestPartitionPoint(t *testing.T) {
+	type testCase struct {
+		name     string
+		in       []int
+		expected int
+	}
+	small := func(n int) bool { return n < 5 }
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, expected: 0},
+		{name: "all true", in: []int{1, 2, 3}, expected: 3},
+		{name: "all false", in: []int{5, 6, 7}, expected: 0},
+		{name: "partitioned", in: []int{4, 1, 3, 9, 5, 7}, expected: 3},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, PartitionPoint(tt.in, small))
+		})
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	var s []int
+	for i := 0; i < 200; i++ {
+		x := rng.Intn(20)
+		s = InsertSorted(x, s)
+		require.True(t, sort.IntsAreSorted(s))
+	}
+	require.Len(t, s, 200)
+	type item struct {
+		key int
+		id  int
+	}
+	cmp := func(a, b item) int { return a.key - b.key }
+	items := []item{{1, 0}, {2, 1}, {2, 2}, {3, 3}}
+	items = InsertSortedFunc(item{2, 4}, items, cmp)
+	require.Equal(t, []item{{1, 0}, {2, 4}, {2, 1}, {2, 2}, {3, 3}}, items)
+}
+
+func TestPartition3(t *testing.T) {
+	type testCase struct {
+		name  string
+		in    []int
+		value int
+	}
+	rng := rand.New(rand.NewSource(42))
+	dups := make([]int, 100)
+	for i := range dups {
+		dups[i] = rng.Intn(5)
+	}
+	cases := []testCase{
+		{name: "empty slice", in: []int{}, value: 0},
+		{name: "shuffled slice", in: shuffleSlice(10, 1), value: 5},
+		{name: "value not present", in: shuffleSlice(10, 2), value: 5},
+		{name: "value before range", in: shuffleSlice(10, 1), value: -1},
+		{name: "value after range", in: shuffleSlice(10, 1), value: 20},
+		{name: "many duplicates", in: dups, value: 2},
+		{name: "all equal", in: []int{3, 3, 3, 3}, value: 3},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := CountBy(tt.in, func(n int) int { return n })
+			lt, gt := Partition3(tt.value, tt.in)
+			require.LessOrEqual(t, lt, gt)
+			require.LessOrEqual(t, gt, len(tt.in))
+			for _, e := range tt.in[:lt] {
+				require.Less(t, e, tt.value)
+			}
+			for _, e := range tt.in[lt:gt] {
+				require.Equal(t, tt.value, e)
+			}
+			for _, e := range tt.in[gt:] {
+				require.Greater(t, e, tt.value)
+			}
+			require.Equal(t, counts, CountBy(tt.in, func(n int) int { return n }))
+		})
+	}
+}
+
+func TestStablePartition(t *testing.T) {
+	type item struct {
+		key int
+		id  int
+	}
+	type testCase struct {
+		name      string
+		in        []item
+		predicate func(item) bool
+	}
+	rng := rand.New(rand.NewSource(42))
+	random := make([]item, 200)
+	for i := range random {
+		random[i] = item{key: rng.Intn(10), id: i}
+	}
+	even := func(e item) bool { return e.key%2 == 0 }
+	cases := []testCase{
+		{name: "empty slice", in: []item{}, predicate: even},
+		{name: "single element", in: []item{{1, 0}}, predicate: even},
+		{name: "always true", in: random, predicate: func(item) bool { return true }},
+		{name: "always false", in: random, predicate: func(item) bool { return false }},
+		{name: "even keys", in: random, predicate: even},
+		{name: "small keys", in: random, predicate: func(e item) bool { return e.key < 3 }},
+	}
+	partitions := map[string]func(func(item) bool, []item) int{
+		"StablePartition":        StablePartition[item],
+		"StablePartitionInPlace": StablePartitionInPlace[item],
+	}
+	for _, tt := range cases {
+		for name, partition := range partitions {
+			t.Run(name+" "+tt.name, func(t *testing.T) {
+				yes, no := PartitionBy(tt.in, tt.predicate)
+				s := append([]item{}, tt.in...)
+				p := partition(tt.predicate, s)
+				require.Equal(t, len(yes), p)
+				require.True(t, IsPartitioned(tt.predicate, s))
+				// stability: both parts keep the input order
+				require.Equal(t, yes, s[:p])
+				require.Equal(t, no, s[p:])
+			})
+		}
+	}
+}
+
+func TestIsPartitioned(t *testing.T) {
+	even := func(n int) bool { return n%2 == 0 }
+	require.True(t, IsPartitioned(even, []int{}))
+	require.True(t, IsPartitioned(even, []int{2, 4, 1, 3}))
+	require.True(t, IsPartitioned(even, []int{1, 3}))
+	require.True(t, IsPartitioned(even, []int{2, 4}))
+	require.False(t, IsPartitioned(even, []int{2, 1, 4}))
+	require.False(t, IsPartitioned(even, []int{1, 2}))
+	s := shuffleSlice(20, 1)
+	PartitionFilter(even, s)
+	require.True(t, IsPartitioned(even, s))
+}
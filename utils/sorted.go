@@ -0,0 +1,215 @@
+package utils
+
+import (
+	"container/heap"
+
+	"golang.org/x/exp/constraints"
+)
+
+/*
+ * Set algebra on sorted slices
+ *
+ * Inputs must be sorted (w.r.t. the comparator for *Func variants) and may contain duplicates, in
+ * which case they behave like multisets (as C++ std::set_union and friends): an element present
+ * m times in s1 and n times in s2 appears max(m, n) times in the union, min(m, n) times in the
+ * intersection and m - n times in the difference. All functions run in linear time and return
+ * sorted slices.
+ *
+ * Comparators are three-way: cmp(a, b) is negative if a < b, 0 if a == b and positive if a > b.
+ */
+
+// compareOrdered is the three-way comparator of ordered types
+func compareOrdered[T constraints.Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case b < a:
+		return 1
+	}
+	return 0
+}
+
+// setOperation merges s1 and s2, onlyFirst, onlySecond and both tell whether to keep elements only in s1,
+// only in s2 and in both
+func setOperation[T any](s1, s2 []T, cmp func(T, T) int, onlyFirst, onlySecond, both bool) []T {
+	r := []T{}
+	i, j := 0, 0
+	for i < len(s1) && j < len(s2) {
+		c := cmp(s1[i], s2[j])
+		switch {
+		case c < 0:
+			if onlyFirst {
+				r = append(r, s1[i])
+			}
+			i++
+		case c > 0:
+			if onlySecond {
+				r = append(r, s2[j])
+			}
+			j++
+		default:
+			if both {
+				r = append(r, s1[i])
+			}
+			i++
+			j++
+		}
+	}
+	if onlyFirst {
+		r = append(r, s1[i:]...)
+	}
+	if onlySecond {
+		r = append(r, s2[j:]...)
+	}
+	return r
+}
+
+// MergeSortedFunc returns the sorted slice of all elements of s1 and s2, stable: equal elements of s1 come first
+func MergeSortedFunc[T any](s1, s2 []T, cmp func(T, T) int) []T {
+	r := make([]T, 0, len(s1)+len(s2))
+	i, j := 0, 0
+	for i < len(s1) && j < len(s2) {
+		if cmp(s2[j], s1[i]) < 0 {
+			r = append(r, s2[j])
+			j++
+			continue
+		}
+		r = append(r, s1[i])
+		i++
+	}
+	r = append(r, s1[i:]...)
+	return append(r, s2[j:]...)
+}
+
+// MergeSorted returns the sorted slice of all elements of s1 and s2
+func MergeSorted[T constraints.Ordered](s1, s2 []T) []T {
+	return MergeSortedFunc(s1, s2, compareOrdered[T])
+}
+
+// UnionSortedFunc returns the sorted union of s1 and s2
+func UnionSortedFunc[T any](s1, s2 []T, cmp func(T, T) int) []T {
+	return setOperation(s1, s2, cmp, true, true, true)
+}
+
+// UnionSorted returns the sorted union of s1 and s2
+func UnionSorted[T constraints.Ordered](s1, s2 []T) []T {
+	return UnionSortedFunc(s1, s2, compareOrdered[T])
+}
+
+// IntersectSortedFunc returns the sorted intersection of s1 and s2
+func IntersectSortedFunc[T any](s1, s2 []T, cmp func(T, T) int) []T {
+	return setOperation(s1, s2, cmp, false, false, true)
+}
+
+// IntersectSorted returns the sorted intersection of s1 and s2
+func IntersectSorted[T constraints.Ordered](s1, s2 []T) []T {
+	return IntersectSortedFunc(s1, s2, compareOrdered[T])
+}
+
+// DifferenceSortedFunc returns the sorted elements of s1 that are not in s2
+func DifferenceSortedFunc[T any](s1, s2 []T, cmp func(T, T) int) []T {
+	return setOperation(s1, s2, cmp, true, false, false)
+}
+
+// DifferenceSorted returns the sorted elements of s1 that are not in s2
+func DifferenceSorted[T constraints.Ordered](s1, s2 []T) []T {
+	return DifferenceSortedFunc(s1, s2, compareOrdered[T])
+}
+
+// SymmetricDifferenceSortedFunc returns the sorted elements that are either in s1 or in s2 but not in both
+func SymmetricDifferenceSortedFunc[T any](s1, s2 []T, cmp func(T, T) int) []T {
+	return setOperation(s1, s2, cmp, true, true, false)
+}
+
+// SymmetricDifferenceSorted returns the sorted elements that are either in s1 or in s2 but not in both
+func SymmetricDifferenceSorted[T constraints.Ordered](s1, s2 []T) []T {
+	return SymmetricDifferenceSortedFunc(s1, s2, compareOrdered[T])
+}
+
+// IncludesSortedFunc returns true if all elements of sub are in s (with at least the same multiplicity)
+func IncludesSortedFunc[T any](s, sub []T, cmp func(T, T) int) bool {
+	i := 0
+	for _, e := range sub {
+		for i < len(s) && cmp(s[i], e) < 0 {
+			i++
+		}
+		if i == len(s) || cmp(s[i], e) != 0 {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// IncludesSorted returns true if all elements of sub are in s (with at least the same multiplicity)
+func IncludesSorted[T constraints.Ordered](s, sub []T) bool {
+	return IncludesSortedFunc(s, sub, compareOrdered[T])
+}
+
+// mergeHead is the current position in one of the slices merged by MergeK
+type mergeHead struct {
+	slice int
+	pos   int
+}
+
+// mergeHeap is a heap of positions ordered by the element they point to, then by slice index
+type mergeHeap[T any] struct {
+	heads  []mergeHead
+	slices [][]T
+	cmp    func(T, T) int
+}
+
+func (h *mergeHeap[T]) Len() int {
+	return len(h.heads)
+}
+
+func (h *mergeHeap[T]) Less(i, j int) bool {
+	a, b := h.heads[i], h.heads[j]
+	c := h.cmp(h.slices[a.slice][a.pos], h.slices[b.slice][b.pos])
+	return c < 0 || (c == 0 && a.slice < b.slice)
+}
+
+func (h *mergeHeap[T]) Swap(i, j int) {
+	h.heads[i], h.heads[j] = h.heads[j], h.heads[i]
+}
+
+func (h *mergeHeap[T]) Push(x any) {
+	h.heads = append(h.heads, x.(mergeHead))
+}
+
+func (h *mergeHeap[T]) Pop() any {
+	x := h.heads[len(h.heads)-1]
+	h.heads = h.heads[:len(h.heads)-1]
+	return x
+}
+
+// MergeKFunc returns the sorted slice of all elements of the slices, in O(n log k) for k slices of n
+// elements in total, stable: equal elements keep the order of the slices
+func MergeKFunc[T any](slices [][]T, cmp func(T, T) int) []T {
+	total := 0
+	h := &mergeHeap[T]{slices: slices, cmp: cmp}
+	for i, s := range slices {
+		total += len(s)
+		if len(s) > 0 {
+			h.heads = append(h.heads, mergeHead{slice: i})
+		}
+	}
+	heap.Init(h)
+	r := make([]T, 0, total)
+	for h.Len() > 0 {
+		top := h.heads[0]
+		r = append(r, slices[top.slice][top.pos])
+		if top.pos+1 < len(slices[top.slice]) {
+			h.heads[0].pos++
+			heap.Fix(h, 0)
+			continue
+		}
+		heap.Pop(h)
+	}
+	return r
+}
+
+// MergeK returns the sorted slice of all elements of the slices
+func MergeK[T constraints.Ordered](slices [][]T) []T {
+	return MergeKFunc(slices, compareOrdered[T])
+}
@@ -0,0 +1,187 @@
+package utils
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// randomSorted returns a sorted slice of n elements in [0, max) with duplicates
+func randomSorted(rng *rand.Rand, n, max int) []int {
+	r := make([]int, n)
+	for i := range r {
+		r[i] = rng.Intn(max)
+	}
+	sort.Ints(r)
+	return r
+}
+
+// counts returns the number of occurrences of each element of s
+func counts(s []int) map[int]int {
+	return CountBy(s, func(n int) int { return n })
+}
+
+// fromCounts returns the sorted slice containing each key of m m[key] times
+func fromCounts(m map[int]int) []int {
+	r := []int{}
+	for k, n := range m {
+		for i := 0; i < n; i++ {
+			r = append(r, k)
+		}
+	}
+	sort.Ints(r)
+	return r
+}
+
+// referenceSetOperation computes a set operation on multisets using maps, f gives the multiplicity of
+// an element from its multiplicities in both inputs
+func referenceSetOperation(s1, s2 []int, f func(int, int) int) []int {
+	c1, c2 := counts(s1), counts(s2)
+	r := map[int]int{}
+	for k := range c1 {
+		r[k] = f(c1[k], c2[k])
+	}
+	for k := range c2 {
+		r[k] = f(c1[k], c2[k])
+	}
+	return fromCounts(r)
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func TestSortedSetOperations(t *testing.T) {
+	type testCase struct {
+		name      string
+		op        func([]int, []int) []int
+		opFunc    func([]int, []int, func(int, int) int) []int
+		reference func(int, int) int
+	}
+	cases := []testCase{
+		{
+			name:      "merge",
+			op:        MergeSorted[int],
+			opFunc:    MergeSortedFunc[int],
+			reference: func(a, b int) int { return a + b },
+		},
+		{
+			name:      "union",
+			op:        UnionSorted[int],
+			opFunc:    UnionSortedFunc[int],
+			reference: maxInt,
+		},
+		{
+			name:      "intersection",
+			op:        IntersectSorted[int],
+			opFunc:    IntersectSortedFunc[int],
+			reference: minInt,
+		},
+		{
+			name:      "difference",
+			op:        DifferenceSorted[int],
+			opFunc:    DifferenceSortedFunc[int],
+			reference: func(a, b int) int { return maxInt(a-b, 0) },
+		},
+		{
+			name:      "symmetric difference",
+			op:        SymmetricDifferenceSorted[int],
+			opFunc:    SymmetricDifferenceSortedFunc[int],
+			reference: func(a, b int) int { return maxInt(a-b, b-a) },
+		},
+	}
+	rng := rand.New(rand.NewSource(42))
+	reversed := func(a, b int) int { return compareOrdered(b, a) }
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, []int{}, tt.op([]int{}, []int{}))
+			for i := 0; i < 200; i++ {
+				s1 := randomSorted(rng, rng.Intn(30), 20)
+				s2 := randomSorted(rng, rng.Intn(30), 20)
+				expected := referenceSetOperation(s1, s2, tt.reference)
+				require.Equal(t, expected, tt.op(s1, s2))
+				Reverse(s1)
+				Reverse(s2)
+				r := tt.opFunc(s1, s2, reversed)
+				Reverse(r)
+				require.Equal(t, expected, r)
+			}
+		})
+	}
+}
+
+func TestMergeSortedStable(t *testing.T) {
+	type pair struct {
+		key    int
+		origin string
+	}
+	cmp := func(a, b pair) int { return compareOrdered(a.key, b.key) }
+	s1 := []pair{{1, "a"}, {2, "a"}, {2, "a"}}
+	s2 := []pair{{0, "b"}, {2, "b"}, {3, "b"}}
+	expected := []pair{{0, "b"}, {1, "a"}, {2, "a"}, {2, "a"}, {2, "b"}, {3, "b"}}
+	require.Equal(t, expected, MergeSortedFunc(s1, s2, cmp))
+	require.Equal(t, expected, MergeKFunc([][]pair{s1, s2}, cmp))
+}
+
+func TestIncludesSorted(t *testing.T) {
+	type testCase struct {
+		name     string
+		s        []int
+		sub      []int
+		expected bool
+	}
+	cases := []testCase{
+		{name: "empty slices", s: []int{}, sub: []int{}, expected: true},
+		{name: "empty subset", s: []int{1, 2}, sub: []int{}, expected: true},
+		{name: "empty set", s: []int{}, sub: []int{1}, expected: false},
+		{name: "included", s: []int{1, 2, 2, 3, 5}, sub: []int{2, 2, 5}, expected: true},
+		{name: "missing element", s: []int{1, 2, 3, 5}, sub: []int{2, 4}, expected: false},
+		{name: "not enough duplicates", s: []int{1, 2, 3}, sub: []int{2, 2}, expected: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IncludesSorted(tt.s, tt.sub))
+		})
+	}
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		s1 := randomSorted(rng, rng.Intn(30), 10)
+		s2 := randomSorted(rng, rng.Intn(5), 10)
+		expected := len(DifferenceSorted(s2, s1)) == 0
+		require.Equal(t, expected, IncludesSorted(s1, s2))
+		require.True(t, IncludesSorted(UnionSorted(s1, s2), s2))
+		require.True(t, IncludesSorted(s1, IntersectSorted(s1, s2)))
+	}
+}
+
+func TestMergeK(t *testing.T) {
+	require.Equal(t, []int{}, MergeK([][]int{}))
+	require.Equal(t, []int{}, MergeK([][]int{{}, {}}))
+	rng := rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		var slices [][]int
+		var all []int
+		for k := rng.Intn(10); k >= 0; k-- {
+			s := randomSorted(rng, rng.Intn(20), 50)
+			slices = append(slices, s)
+			all = append(all, s...)
+		}
+		sort.Ints(all)
+		if all == nil {
+			all = []int{}
+		}
+		require.Equal(t, all, MergeK(slices))
+	}
+}
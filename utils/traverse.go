@@ -0,0 +1,45 @@
+package utils
+
+// TraverseOption applies f to all elements of s and returns the slice of results if all of them have a value
+// stops at the first empty result and returns an empty optional
+func TraverseOption[T1, T2 any](s []T1, f func(T1) Option[T2]) Option[[]T2] {
+	r := make([]T2, 0, len(s))
+	for _, e := range s {
+		o := f(e)
+		if !o.HasValue() {
+			return NilOption[[]T2]()
+		}
+		r = append(r, o.Value())
+	}
+	return NewOption(r)
+}
+
+// SequenceOptions returns the values of all elements of s if none of them is empty, returns an empty optional otherwise
+func SequenceOptions[T any](s []Option[T]) Option[[]T] {
+	return TraverseOption(s, func(o Option[T]) Option[T] { return o })
+}
+
+// TraverseResult applies f to all elements of s and returns the slice of values if all results are successful
+// stops at the first failure and returns its error
+func TraverseResult[T1, T2 any](s []T1, f func(T1) Result[T2]) Result[[]T2] {
+	r := make([]T2, 0, len(s))
+	for _, e := range s {
+		v, err := f(e).Get()
+		if err != nil {
+			return Err[[]T2](err)
+		}
+		r = append(r, v)
+	}
+	return Ok(r)
+}
+
+// SequenceResults returns the values of all elements of s if all of them are successful, returns the first error otherwise
+func SequenceResults[T any](s []Result[T]) Result[[]T] {
+	return FromPair(CollectResults(s))
+}
+
+// CatOptions returns the values of the non-empty elements of s
+// Counterpart of OptionalFilter for already computed optional values
+func CatOptions[T any](s []Option[T]) []T {
+	return OptionalFilter(func(o Option[T]) Option[T] { return o }, s)
+}
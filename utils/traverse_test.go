@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraverseOption(t *testing.T) {
+	type testCases struct {
+		name     string
+		in       []string
+		expected Option[[]int]
+		calls    int
+	}
+	cases := []testCases{
+		{name: "empty slice", in: []string{}, expected: NewOption([]int{}), calls: 0},
+		{name: "all values", in: []string{"1", "2", "3"}, expected: NewOption([]int{1, 2, 3}), calls: 3},
+		{name: "stop at first empty", in: []string{"1", "a", "3"}, expected: NilOption[[]int](), calls: 2},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			parse := func(s string) Option[int] {
+				calls++
+				return FromError(strconv.Atoi(s))
+			}
+			r := TraverseOption(tt.in, parse)
+			require.Equal(t, tt.calls, calls)
+			require.Equal(t, tt.expected.HasValue(), r.HasValue())
+			if tt.expected.HasValue() {
+				require.Equal(t, tt.expected.Value(), r.Value())
+			}
+			seq := SequenceOptions(Map(tt.in, func(s string) Option[int] { return FromError(strconv.Atoi(s)) }))
+			require.Equal(t, tt.expected.HasValue(), seq.HasValue())
+			if tt.expected.HasValue() {
+				require.Equal(t, tt.expected.Value(), seq.Value())
+			}
+		})
+	}
+}
+
+func TestTraverseResult(t *testing.T) {
+	type testCases struct {
+		name     string
+		in       []string
+		expected []int
+		failed   bool
+		calls    int
+	}
+	cases := []testCases{
+		{name: "empty slice", in: []string{}, expected: []int{}, calls: 0},
+		{name: "all values", in: []string{"1", "2", "3"}, expected: []int{1, 2, 3}, calls: 3},
+		{name: "stop at first error", in: []string{"1", "a", "b"}, failed: true, calls: 2},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			parse := func(s string) Result[int] {
+				calls++
+				return FromPair(strconv.Atoi(s))
+			}
+			r := TraverseResult(tt.in, parse)
+			require.Equal(t, tt.calls, calls)
+			seq := SequenceResults(Map(tt.in, func(s string) Result[int] { return FromPair(strconv.Atoi(s)) }))
+			if tt.failed {
+				var numErr *strconv.NumError
+				require.True(t, r.As(&numErr))
+				require.Equal(t, "a", numErr.Num)
+				require.True(t, seq.As(&numErr))
+				require.Equal(t, "a", numErr.Num)
+				return
+			}
+			require.Equal(t, tt.expected, r.Unwrap())
+			require.Equal(t, tt.expected, seq.Unwrap())
+		})
+	}
+	require.True(t, SequenceResults([]Result[int]{Ok(1), Err[int](errTest), Err[int](errors.New("other"))}).Is(errTest))
+}
+
+func TestCatOptions(t *testing.T) {
+	in := []Option[int]{NewOption(1), NilOption[int](), NewOption(2), NilOption[int](), NewOption(3)}
+	require.Equal(t, []int{1, 2, 3}, CatOptions(in))
+	require.Empty(t, CatOptions([]Option[int]{NilOption[int]()}))
+	require.Empty(t, CatOptions([]Option[int]{}))
+}